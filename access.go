@@ -0,0 +1,114 @@
+package tenure
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// accessBatch is the number of accesses buffered per stripe before they are applied to the recency list
+const accessBatch = 64
+
+// WithBufferedAccess configures the cache to record hits in lossy buffers and apply their recency updates in
+// batches, rather than moving each entry to the front of the recency list under the write lock upon every hit
+// Hits are then served under the read lock, and proceed in parallel. In exchange, recency is approximate:
+// buffered accesses are applied late, and may be dropped altogether, e.g. while another batch is being
+// applied, such that an entry recently hit may be evicted ahead of one that was not. Hits and misses in Stats
+// remain exact, while Protected and Probationary are subject to the same loss
+// Hits on entries that slide, or that are found expired, are served under the write lock as usual
+func WithBufferedAccess() Option {
+	return func(lc *LRUCache) {
+		lc.accesses = &accessBuffer{}
+		lc.accesses.stripes.New = func() interface{} { return new(accessStripe) }
+	}
+}
+
+// accessBuffer records hits served under the read lock, applying them to the cache in batches
+// Stripes are drawn from a sync.Pool, which caches them per P, such that concurrent readers seldom share one;
+// stripes dropped by the pool take their buffered accesses with them
+type accessBuffer struct {
+	// hits and negativeHits count the hits served under the read lock; see (*accessBuffer).count
+	hits         uint64
+	negativeHits uint64
+	// draining is set while a batch is being applied; batches arriving meanwhile are dropped
+	draining int32
+	stripes  sync.Pool
+}
+
+// accessStripe buffers the revisions of the entries hit
+type accessStripe struct {
+	buf [accessBatch]revision
+	n   int
+}
+
+/* Utilities */
+
+// peek attempts to serve a hit on the entry for an already-mapped key under the read lock, recording the
+// access. It reports false where the transaction must instead be served under the write lock, i.e. on a miss,
+// or where the entry is expired, sliding, or subject to sampled verification
+func (lc *LRUCache) peek(key interface{}) (hit pair, rev revision, ok bool) {
+	lc.lock.RLock()
+
+	kv, ok := lc.cache[key]
+	if !ok || kv.sliding || lc.debug != nil || !kv.hardExpiry.IsZero() && kv.expired(lc.clock.Now()) {
+		lc.lock.RUnlock()
+		return pair{}, revision{}, false
+	}
+
+	hit, rev = *kv, revision{kv, kv.gen}
+	lc.lock.RUnlock()
+
+	if hit.negative {
+		atomic.AddUint64(&lc.accesses.negativeHits, 1)
+	} else {
+		atomic.AddUint64(&lc.accesses.hits, 1)
+	}
+
+	lc.accesses.record(lc, rev)
+	return hit, rev, true
+}
+
+// record buffers an access to the entry at revision `rev`, applying the stripe's accesses once it fills
+func (b *accessBuffer) record(lc *LRUCache, rev revision) {
+	s := b.stripes.Get().(*accessStripe)
+
+	s.buf[s.n] = rev
+	if s.n++; s.n == accessBatch {
+		if atomic.CompareAndSwapInt32(&b.draining, 0, 1) {
+			lc.lock.Lock()
+			lc.applyAccesses(s.buf[:])
+			lc.lock.Unlock()
+			atomic.StoreInt32(&b.draining, 0)
+		}
+
+		s.buf, s.n = [accessBatch]revision{}, 0
+	}
+
+	b.stripes.Put(s)
+}
+
+// applyAccesses designates the entries at the given revisions as most recently-used, in order of access,
+// skipping those since removed or updated. The write lock must be held
+func (lc *LRUCache) applyAccesses(revs []revision) {
+	for _, rev := range revs {
+		kv := rev.p
+		if kv.list != lc.links || kv.gen != rev.gen {
+			continue
+		}
+
+		lc.promote(kv)
+		if kv.hits == 0 {
+			lc.protected++
+		}
+		kv.hits++
+	}
+}
+
+// count adds the hits served under the read lock to `s`
+func (b *accessBuffer) count(s *Stats) {
+	if b == nil {
+		return
+	}
+
+	s.Hits += atomic.LoadUint64(&b.hits)
+	s.NegativeHits += atomic.LoadUint64(&b.negativeHits)
+}
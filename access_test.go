@@ -0,0 +1,84 @@
+package tenure
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestBufferedAccess(t *testing.T) {
+	lru, err := New(4, nil, WithBufferedAccess())
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 4; i++ {
+		lru.Put(i, i)
+	}
+
+	for i := 0; i < 10; i++ {
+		if v, ok := lru.Get(0); !ok || v != 0 {
+			t.Fatalf("Buffered hit mismatch; Have %v, Want %v", v, 0)
+		}
+	}
+	lru.Get(99)
+
+	if s := lru.Stats(); s.Hits != 10 || s.Misses != 1 {
+		t.Fatalf("Stats should count buffered hits exactly; Have (%v, %v), Want (%v, %v)", s.Hits, s.Misses, 10, 1)
+	}
+
+	// Applying the buffered accesses promotes the entry, sparing it from eviction
+	kv := lru.cache[0]
+	lru.lock.Lock()
+	lru.applyAccesses([]revision{{kv, kv.gen}})
+	lru.lock.Unlock()
+
+	lru.Put(4, 4)
+	if !lru.Has(0) || lru.Has(1) {
+		t.Fatal("Applied accesses should designate the entry as most recently-used")
+	}
+
+	// Accesses to entries since removed or updated are skipped
+	stale := revision{kv, kv.gen}
+	lru.Put(0, 0)
+	lru.Del(2)
+	lru.lock.Lock()
+	lru.applyAccesses([]revision{stale, {kv, kv.gen - 1}})
+	lru.lock.Unlock()
+
+	if have, want := fmt.Sprint(lru.Keys()), "[3 4 0]"; have != want {
+		t.Fatalf("Keys mismatch; Have %v, Want %v", have, want)
+	}
+}
+
+func TestBufferedAccessConcurrency(t *testing.T) {
+	lru, err := New(64, nil, WithBufferedAccess())
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				k := (i*7 + g) % 128
+				if i%8 == 0 {
+					lru.Put(k, i)
+				} else {
+					lru.Get(k)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if s := lru.Stats(); s.Hits+s.Misses != 8*(2000-250) {
+		t.Fatalf("Stats should count every lookup; Have %v, Want %v", s.Hits+s.Misses, 8*(2000-250))
+	}
+
+	if lru.Size() != 64 || lru.links.Len() != len(lru.cache) {
+		t.Fatal("Buffered accesses should not corrupt the recency list")
+	}
+}
@@ -0,0 +1,67 @@
+package tenure
+
+// Append atomically extends the string or byte-slice value for the given key by `suffix`, a string or byte slice,
+// creating the entry at `suffix` if absent, and returns the length of the value thereby stored. The extended value
+// is of the type of the extant value, and is stored afresh, such that values previously returned are never
+// modified. Returns ErrWrongType, leaving the entry as is, should either value be of another type, or else any
+// error of Update; should the extended value exceed the maximum entry cost, it is refused and the entry removed,
+// as by TryPut. Expired and negative entries are reported as absent. The entry is stored as by PutWith, per `opts`
+func (lc *LRUCache) Append(key, suffix interface{}, opts ...EntryOption) (length int, err error) {
+	var wrongType bool
+
+	_, err = lc.Update(key, func(old interface{}, exists bool) (interface{}, bool) {
+		head, tail := old, suffix
+		if !exists {
+			head, tail = suffix, nil
+		}
+
+		v, ok := concat(head, tail)
+		if wrongType = !ok; wrongType {
+			return nil, false
+		}
+
+		length = lengthOf(v)
+		return v, true
+	}, opts...)
+
+	if err == nil && wrongType {
+		err = ErrWrongType
+	}
+
+	return
+}
+
+/* Utilities */
+
+// concat returns a fresh value, of the type of `v`, extending the string or byte slice `v` by the string or
+// byte slice `suffix`, if not nil
+func concat(v, suffix interface{}) (interface{}, bool) {
+	var tail string
+	switch s := suffix.(type) {
+	case nil:
+	case string:
+		tail = s
+	case []byte:
+		tail = string(s)
+	default:
+		return nil, false
+	}
+
+	switch v := v.(type) {
+	case string:
+		return v + tail, true
+	case []byte:
+		b := make([]byte, 0, len(v)+len(tail))
+		return append(append(b, v...), tail...), true
+	}
+
+	return nil, false
+}
+
+func lengthOf(v interface{}) int {
+	if s, ok := v.(string); ok {
+		return len(s)
+	}
+
+	return len(v.([]byte))
+}
@@ -0,0 +1,59 @@
+package tenure
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAppend(t *testing.T) {
+	lru, _ := New(3, nil)
+
+	if n, err := lru.Append("log", "a"); n != 1 || err != nil {
+		t.Fatalf("Append should create an absent entry at the suffix; Have %v, %v", n, err)
+	}
+
+	if n, err := lru.Append("log", []byte("bc")); n != 3 || err != nil {
+		t.Fatalf("Append should extend the extant value; Have %v, %v", n, err)
+	}
+
+	if v, _ := lru.Get("log"); v != "abc" {
+		t.Errorf("Value mismatch; Have %v, Want %v", v, "abc")
+	}
+
+	lru.Put("n", 1)
+	if _, err := lru.Append("n", "a"); err != ErrWrongType {
+		t.Errorf("Append to a non-string value should fail; Have %v, Want %v", err, ErrWrongType)
+	}
+
+	if _, err := lru.Append("log", 1); err != ErrWrongType {
+		t.Errorf("Append of a non-string suffix should fail; Have %v, Want %v", err, ErrWrongType)
+	}
+}
+
+func TestAppendBytes(t *testing.T) {
+	lru, _ := New(3, nil)
+
+	buf := make([]byte, 1, 8)
+	buf[0] = 'a'
+	lru.Put("buf", buf)
+	lru.Append("buf", "b")
+
+	if v, _ := lru.Get("buf"); !bytes.Equal(v.([]byte), []byte("ab")) {
+		t.Errorf("Value mismatch; Have %q, Want %q", v, "ab")
+	}
+
+	if !bytes.Equal(buf[:cap(buf)][:2], []byte{'a', 0}) {
+		t.Error("Append should not modify the prior value")
+	}
+}
+
+func TestAppendTooLarge(t *testing.T) {
+	lru, _ := New(3, nil, WithMaxEntryCost(4), WithSizer(func(key, value interface{}) int64 {
+		return int64(len(value.(string)))
+	}))
+
+	lru.Append("log", "abc")
+	if _, err := lru.Append("log", "de"); err != ErrTooLarge {
+		t.Errorf("Append beyond the maximum entry cost should fail; Have %v, Want %v", err, ErrTooLarge)
+	}
+}
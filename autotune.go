@@ -0,0 +1,138 @@
+package tenure
+
+import "time"
+
+// AutoTuneConfig configures the capacity auto-tuner; see WithAutoTune
+type AutoTuneConfig struct {
+	// Min and Max bound the capacity the tuner may adopt
+	Min int
+	Max int
+	// TargetHitRate is the hit rate, in [0, 1], the tuner seeks to hold
+	TargetHitRate float64
+	// Tolerance is the margin about the target within which the capacity is left be; it defaults to 0.02
+	Tolerance float64
+	// Step is the share of the current capacity by which each adjustment grows or shrinks it; it defaults to 0.1
+	Step float64
+	// Window is the interval over which each hit rate is observed; it defaults to one minute
+	Window time.Duration
+	// MinLookups is the number of lookups below which a window is disregarded as unrepresentative; it defaults
+	// to 100
+	MinLookups uint64
+}
+
+// WithAutoTune configures the cache to adjust its capacity within [Min, Max] so as to hold a target hit rate
+// At the close of each window, the hit rate observed therein is compared to the target: a shortfall beyond the
+// tolerance grows the capacity by one step, and a surplus beyond it shrinks the capacity by one step, evicting
+// least recently-used entries as would AdjustCapacity; the capacity is thereby the least that meets the target
+// Each adjustment is emitted as an EventResize. Close must be invoked to halt the tuner
+func WithAutoTune(cfg AutoTuneConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.Min <= 0 || cfg.Max < cfg.Min {
+			return
+		}
+
+		if cfg.Tolerance <= 0 {
+			cfg.Tolerance = 0.02
+		}
+
+		if cfg.Step <= 0 {
+			cfg.Step = 0.1
+		}
+
+		if cfg.Window <= 0 {
+			cfg.Window = time.Minute
+		}
+
+		if cfg.MinLookups == 0 {
+			cfg.MinLookups = 100
+		}
+
+		lc.tuner = &tuner{cfg: cfg}
+	}
+}
+
+/* Utilities */
+
+type tuner struct {
+	cfg AutoTuneConfig
+	lc  *LRUCache
+	// hits and misses are the cache's counts as of the close of the previous window
+	hits   uint64
+	misses uint64
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func (t *tuner) start(lc *LRUCache) {
+	t.lc = lc
+	t.stop = make(chan struct{})
+	t.done = make(chan struct{})
+
+	s := lc.Stats()
+	t.hits, t.misses = s.Hits, s.Misses
+
+	go t.run()
+}
+
+func (t *tuner) run() {
+	defer close(t.done)
+
+	for pause(t.lc.clock, t.cfg.Window, t.stop) {
+		t.tune()
+	}
+}
+
+func (t *tuner) close() error {
+	select {
+	case <-t.stop:
+	default:
+		close(t.stop)
+		<-t.done
+	}
+
+	return nil
+}
+
+// tune closes the current window, adjusting the capacity per the hit rate observed therein
+// Returns the capacity adopted
+func (t *tuner) tune() int {
+	s := t.lc.Stats()
+	hits, misses := s.Hits-t.hits, s.Misses-t.misses
+	t.hits, t.misses = s.Hits, s.Misses
+
+	capacity := s.Capacity
+	if hits+misses < t.cfg.MinLookups {
+		return capacity
+	}
+
+	step := int(float64(capacity) * t.cfg.Step)
+	if step < 1 {
+		step = 1
+	}
+
+	rate := float64(hits) / float64(hits+misses)
+	switch {
+	case rate < t.cfg.TargetHitRate-t.cfg.Tolerance:
+		capacity += step
+	case rate > t.cfg.TargetHitRate+t.cfg.Tolerance:
+		capacity -= step
+	}
+
+	if capacity < t.cfg.Min {
+		capacity = t.cfg.Min
+	}
+
+	if capacity > t.cfg.Max {
+		capacity = t.cfg.Max
+	}
+
+	if capacity != s.Capacity {
+		t.lc.AdjustCapacity(capacity)
+
+		t.lc.lock.Lock()
+		t.lc.emitResize(capacity)
+		t.lc.lock.Unlock()
+	}
+
+	return capacity
+}
@@ -0,0 +1,58 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAutoTune(t *testing.T) {
+	events := make(chan Event, 16)
+
+	lru, err := New(10, nil, WithChangeChannel(events), WithAutoTune(AutoTuneConfig{
+		Min:           10,
+		Max:           12,
+		TargetHitRate: 0.9,
+		Window:        time.Hour,
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	lookups := func(hits, misses int) {
+		lru.Put("hit", 1)
+		<-events
+		for i := 0; i < hits; i++ {
+			lru.Get("hit")
+		}
+		for i := 0; i < misses; i++ {
+			lru.Get("miss")
+		}
+	}
+
+	lookups(50, 50)
+	if c := lru.tuner.tune(); c != 11 {
+		t.Fatalf("A shortfall should grow the capacity; Have %v, Want %v", c, 11)
+	}
+
+	if e := <-events; e.Op != EventResize || e.Value != 11 {
+		t.Fatalf("Adjustments should be emitted; Have %v %v", e.Op, e.Value)
+	}
+
+	lookups(50, 50)
+	lookups(50, 50)
+	if c := lru.tuner.tune(); c != 12 {
+		t.Fatalf("The capacity should not exceed its maximum; Have %v, Want %v", c, 12)
+	}
+	<-events
+
+	lookups(10, 0)
+	if c := lru.tuner.tune(); c != 12 {
+		t.Fatalf("Windows with too few lookups should be disregarded; Have %v, Want %v", c, 12)
+	}
+
+	lookups(200, 0)
+	if c := lru.tuner.tune(); c != 11 || lru.Capacity() != 11 {
+		t.Fatalf("A surplus should shrink the capacity; Have %v, Want %v", c, 11)
+	}
+}
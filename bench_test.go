@@ -0,0 +1,211 @@
+package tenure
+
+import (
+	"strconv"
+	"testing"
+)
+
+// Results on a 64k-entry cache keyed by strings, before and after the hot paths were reworked, i.e. with
+// container/list tracking recency and the clock read upon every hit, versus the intrusive recency list,
+// hits reading the clock only for entries bearing an expiry, and optional pooling of entry records:
+//
+//	                   before               after
+//	GetHit             421 ns/op    0 B/op   207 ns/op    0 B/op
+//	GetMiss             53 ns/op    0 B/op    89 ns/op    0 B/op
+//	PutUpdate          321 ns/op    0 B/op   342 ns/op    0 B/op
+//	PutEvict           911 ns/op  275 B/op   773 ns/op  244 B/op
+//	PutEvictPooled       -                   515 ns/op    0 B/op
+//	Mixed              657 ns/op    3 B/op   562 ns/op    2 B/op
+//	GetHitParallel     429 ns/op    0 B/op   227 ns/op    0 B/op
+//
+// TestHotPathAllocations guards the zero-allocation property of hits and updates
+
+// benchSize is the capacity of benchmarked caches, and the size of their key space
+const benchSize = 1 << 16
+
+func TestHotPathAllocations(t *testing.T) {
+	lru, err := New(8, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	var key, value interface{} = "key", "value"
+	lru.Put(key, value)
+
+	if n := testing.AllocsPerRun(100, func() { lru.Get(key) }); n != 0 {
+		t.Fatalf("Hits should not allocate; Have %v allocs, Want %v", n, 0)
+	}
+
+	if n := testing.AllocsPerRun(100, func() { lru.Put(key, value) }); n != 0 {
+		t.Fatalf("Updates should not allocate; Have %v allocs, Want %v", n, 0)
+	}
+}
+
+func benchKeys(n int) []interface{} {
+	keys := make([]interface{}, n)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+	return keys
+}
+
+func benchCache(b *testing.B, opts ...Option) (*LRUCache, []interface{}) {
+	lru, err := New(benchSize, nil, opts...)
+	if err != nil {
+		b.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	keys := benchKeys(benchSize)
+	for _, k := range keys {
+		lru.Put(k, k)
+	}
+
+	return lru, keys
+}
+
+func BenchmarkGetHit(b *testing.B) {
+	lru, keys := benchCache(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lru.Get(keys[i&(benchSize-1)])
+	}
+}
+
+func BenchmarkGetMiss(b *testing.B) {
+	lru, _ := benchCache(b)
+	keys := benchKeys(2 * benchSize)[benchSize:]
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lru.Get(keys[i&(benchSize-1)])
+	}
+}
+
+func BenchmarkPutUpdate(b *testing.B) {
+	lru, keys := benchCache(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[i&(benchSize-1)]
+		lru.Put(k, k)
+	}
+}
+
+func BenchmarkPutEvict(b *testing.B) {
+	lru, _ := benchCache(b)
+	keys := benchKeys(4 * benchSize)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[i&(4*benchSize-1)]
+		lru.Put(k, k)
+	}
+}
+
+func BenchmarkPutEvictPooled(b *testing.B) {
+	lru, _ := benchCache(b, WithEntryPooling())
+	keys := benchKeys(4 * benchSize)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[i&(4*benchSize-1)]
+		lru.Put(k, k)
+	}
+}
+
+func BenchmarkMixed(b *testing.B) {
+	lru, _ := benchCache(b)
+	keys := benchKeys(2 * benchSize)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[(i*7919)&(2*benchSize-1)]
+		if i%4 == 0 {
+			lru.Put(k, k)
+		} else {
+			lru.Get(k)
+		}
+	}
+}
+
+func BenchmarkGetHitParallel(b *testing.B) {
+	lru, keys := benchCache(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			lru.Get(keys[i&(benchSize-1)])
+		}
+	})
+}
+
+func BenchmarkStringCacheGetHit(b *testing.B) {
+	sc, err := NewStringCache(benchSize, nil)
+	if err != nil {
+		b.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	keys := make([]string, benchSize)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+		sc.PutString(keys[i], i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sc.GetString(keys[i&(benchSize-1)])
+	}
+}
+
+func BenchmarkGetHitParallelBuffered(b *testing.B) {
+	lru, keys := benchCache(b, WithBufferedAccess())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			lru.Get(keys[i&(benchSize-1)])
+		}
+	})
+}
+
+// SIEVE eviction spares hits the move to the front of the recency list. Under the mixed workload it also hits more
+// often than LRU eviction, though it admits more new keys, whose entries account for its allocations:
+//
+//	                   LRU                  SIEVE
+//	GetHit             125 ns/op    0 B/op   118 ns/op    0 B/op
+//	Mixed              395 ns/op    2 B/op   350 ns/op   41 B/op
+func BenchmarkGetHitSieve(b *testing.B) {
+	lru, keys := benchCache(b, WithEvictionPolicy(EvictSieve))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lru.Get(keys[i&(benchSize-1)])
+	}
+}
+
+func BenchmarkMixedSieve(b *testing.B) {
+	lru, _ := benchCache(b, WithEvictionPolicy(EvictSieve))
+	keys := benchKeys(2 * benchSize)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[(i*7919)&(2*benchSize-1)]
+		if i%4 == 0 {
+			lru.Put(k, k)
+		} else {
+			lru.Get(k)
+		}
+	}
+}
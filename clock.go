@@ -0,0 +1,48 @@
+package tenure
+
+import "time"
+
+// Clock supplies the current time, and schedules the background work, of all time-based features of the cache
+// Injecting a Clock allows expiration behavior and background workers to be unit-tested deterministically,
+// without resorting to `time.Sleep`; see the testutil package for a fake implementation
+type Clock interface {
+	Now() time.Time
+	// NewTimer returns a channel that receives the current time once `d` has elapsed, and a function that
+	// stops the timer, reporting whether it was stopped before firing, as do time.NewTimer and (*time.Timer).Stop
+	NewTimer(d time.Duration) (c <-chan time.Time, stop func() bool)
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	t := time.NewTimer(d)
+	return t.C, t.Stop
+}
+
+// WithClock configures the cache to source the current time from `c` rather than the system clock
+func WithClock(c Clock) Option {
+	return func(lc *LRUCache) {
+		if c != nil {
+			lc.clock = c
+		}
+	}
+}
+
+/* Utilities */
+
+// pause waits for `d` to elapse per the clock `c`, reporting false should `stop` be closed in the interim
+func pause(c Clock, d time.Duration, stop <-chan struct{}) bool {
+	fired, halt := c.NewTimer(d)
+	defer halt()
+
+	select {
+	case <-fired:
+		return true
+	case <-stop:
+		return false
+	}
+}
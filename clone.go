@@ -0,0 +1,70 @@
+package tenure
+
+import "fmt"
+
+// Clone returns an independent cache bearing the cache's entries, with their TTLs, provenance and priorities,
+// in the same order of recency, e.g. for A/B experiments, or to iterate a snapshot at leisure
+// The clone shares neither the eviction callback nor the options of the cache: it is of the same capacity, and
+// is configured afresh by `opts`. Keys are mapped anew by the clone, which must therefore share any key hashing
+// secret. Values are cloned as Get would return them, i.e. shared with the cache absent a codec, unless passed
+// through `copyValue`, which if given returns the value the clone is to bear in lieu of each
+// Entries past their hard expiry are not cloned. Should any entry fail to be decoded by the cache or encoded by
+// the clone, Clone fails with an error naming its key, rather than yielding a clone short of that entry
+func (lc *LRUCache) Clone(copyValue func(value interface{}) interface{}, opts ...Option) (*LRUCache, error) {
+	c, err := New(lc.Capacity(), nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range lc.cloneEntries() {
+		value, err := lc.decode(e.value)
+		if err != nil {
+			return nil, fmt.Errorf("entry %v: %w", e.key, err)
+		}
+
+		if copyValue != nil {
+			value = copyValue(value)
+		}
+
+		encoded, err := c.encode(value)
+		if err != nil {
+			return nil, fmt.Errorf("entry %v: %w", e.key, err)
+		}
+
+		c.insertEncoded(e.key, value, encoded, e.spec)
+	}
+
+	return c, nil
+}
+
+/* Utilities */
+
+// cloneEntry is an entry as retrieved for cloning, bearing its key as given by the caller and its stored value
+type cloneEntry struct {
+	key, value interface{}
+	spec       entrySpec
+}
+
+// cloneEntries returns the entries not past their hard expiry, from least to most recently-used, each with
+// the specification by which to reinstate it
+func (lc *LRUCache) cloneEntries() []cloneEntry {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	now := lc.clock.Now()
+	entries := make([]cloneEntry, 0, lc.links.Len())
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		if kv.expired(now) {
+			continue
+		}
+
+		entries = append(entries, cloneEntry{rawKey(kv.key), kv.value, entrySpec{
+			provenance: kv.provenance,
+			negative:   kv.negative,
+			priority:   kv.priority,
+			restore:    &timestamps{created: kv.created, softExpiry: kv.softExpiry, hardExpiry: kv.hardExpiry},
+		}})
+	}
+
+	return entries
+}
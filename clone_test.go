@@ -0,0 +1,96 @@
+package tenure
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestClone(t *testing.T) {
+	lru, err := New(3, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.PutWithTTL("b", 2, time.Hour, 2*time.Hour)
+	lru.PutWithPriority("c", 3, 5)
+	lru.Get("a")
+
+	c, err := lru.Clone(nil)
+	if err != nil {
+		t.Fatalf("Failed to clone the cache; see %v", err)
+	}
+
+	want, have := lru.Keys(), c.Keys()
+	if len(have) != len(want) {
+		t.Fatalf("Clone keys mismatch; Have %v, Want %v", have, want)
+	}
+	for i := range want {
+		if have[i] != want[i] {
+			t.Fatalf("Clone recency mismatch; Have %v, Want %v", have, want)
+		}
+	}
+
+	orig, _ := lru.Inspect("b")
+	cloned, _ := c.Inspect("b")
+	if !cloned.HardExpiry.Equal(orig.HardExpiry) || !cloned.Created.Equal(orig.Created) {
+		t.Errorf("Clone TTL mismatch; Have %v, Want %v", cloned.HardExpiry, orig.HardExpiry)
+	}
+
+	if info, _ := c.Inspect("c"); info.Priority != 5 {
+		t.Errorf("Clone priority mismatch; Have %v, Want %v", info.Priority, 5)
+	}
+
+	// The clone is independent of the cache
+	c.Put("d", 4)
+	if lru.Has("d") || !lru.Has("b") || c.Has("b") {
+		t.Errorf("Mutations of the clone should not affect the cache; Have %v and %v", lru.Keys(), c.Keys())
+	}
+}
+
+func TestCloneCopiesValues(t *testing.T) {
+	lru, err := New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	lru.Put("a", map[string]int{"n": 1})
+
+	c, err := lru.Clone(func(v interface{}) interface{} {
+		m := make(map[string]int)
+		for k, n := range v.(map[string]int) {
+			m[k] = n
+		}
+		return m
+	})
+	if err != nil {
+		t.Fatalf("Failed to clone the cache; see %v", err)
+	}
+
+	v, _ := c.Get("a")
+	v.(map[string]int)["n"] = 2
+
+	if orig, _ := lru.Get("a"); orig.(map[string]int)["n"] != 1 {
+		t.Errorf("A copied clone should not share values with the cache; Have %v", orig)
+	}
+}
+
+func TestCloneDecodeFailure(t *testing.T) {
+	enc := func(v interface{}) ([]byte, error) { return json.Marshal(v) }
+	dec := func(b []byte) (interface{}, error) {
+		var v int
+		err := json.Unmarshal(b, &v)
+		return v, err
+	}
+
+	lru, err := New(2, nil, WithCodec(enc, dec))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	lru.Put("a", 1)
+	lru.Put("b", "not a number")
+
+	if c, err := lru.Clone(nil); err == nil || c != nil {
+		t.Errorf("Expected an error upon cloning an entry which fails to decode; Have %v", err)
+	}
+}
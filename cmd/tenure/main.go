@@ -0,0 +1,272 @@
+// Command tenure inspects snapshot files, i.e. event logs recorded via tenure.WithChangeStream, by replaying
+// them into a cache and operating on the entries it holds. Usage:
+//
+//	tenure dump    FILE                        list entries, from least to most recently-used
+//	tenure diff    FILE FILE                   list entries added (+), removed (-) or changed (~) between snapshots
+//	tenure filter  -match GLOB FILE            write the entries whose keys match GLOB as a new snapshot
+//	tenure convert -format json|csv FILE       write the entries as a JSON array or CSV
+//	tenure load    -addr URL FILE              store the entries in a running instance over its HTTP API
+//
+// FILE may be "-" to read from standard input
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+const usage = `usage: tenure <command> [flags] FILE...
+
+commands:
+  dump    FILE                     list entries, from least to most recently-used
+  diff    FILE FILE                list entries added (+), removed (-) or changed (~)
+  filter  -match GLOB FILE         write the entries whose keys match GLOB as a new snapshot
+  convert -format json|csv FILE    write the entries as a JSON array or CSV
+  load    -addr URL FILE           store the entries in a running instance over its HTTP API
+`
+
+// entry is a single key / value pair held by a replayed snapshot
+type entry struct {
+	Key   interface{} `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command designated by `args`, returning the process exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	match := fs.String("match", "*", "glob `pattern` keys must match")
+	format := fs.String("format", "json", "output `format`: json or csv")
+	addr := fs.String("addr", "", "base `URL` of a running instance's HTTP API")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	want := map[string]int{"dump": 1, "diff": 2, "filter": 1, "convert": 1, "load": 1}
+	n, ok := want[args[0]]
+	if !ok || fs.NArg() != n {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	snapshots := make([][]entry, n)
+	for i, name := range fs.Args() {
+		s, err := open(name, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "tenure: %s: %v\n", name, err)
+			return 1
+		}
+		snapshots[i] = s
+	}
+
+	var err error
+	switch args[0] {
+	case "dump":
+		err = dump(stdout, snapshots[0])
+	case "diff":
+		err = diff(stdout, snapshots[0], snapshots[1])
+	case "filter":
+		err = filter(stdout, snapshots[0], *match)
+	case "convert":
+		err = convert(stdout, snapshots[0], *format)
+	case "load":
+		err = load(*addr, snapshots[0])
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "tenure: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// open replays the snapshot file `name` into a cache, returning its entries from least to most recently-used
+func open(name string, stdin io.Reader) ([]entry, error) {
+	var r io.Reader = stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// a snapshot can hold no more entries than it has events
+	lru, err := tenure.New(bytes.Count(b, []byte("\n"))+1, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := lru.Replay(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	keys := lru.Keys()
+	entries := make([]entry, len(keys))
+	for i, k := range keys {
+		entries[i] = entry{Key: k, Value: lru.Peek(k)}
+	}
+
+	return entries, nil
+}
+
+func dump(w io.Writer, entries []entry) error {
+	for _, e := range entries {
+		if _, err := fmt.Fprintf(w, "%s=%s\n", format(e.Key), format(e.Value)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func diff(w io.Writer, a, b []entry) error {
+	old := make(map[string]string, len(a))
+	for _, e := range a {
+		old[format(e.Key)] = format(e.Value)
+	}
+
+	seen := make(map[string]bool, len(b))
+	for _, e := range b {
+		k, v := format(e.Key), format(e.Value)
+		seen[k] = true
+
+		prev, ok := old[k]
+		switch {
+		case !ok:
+			fmt.Fprintf(w, "+ %s=%s\n", k, v)
+		case prev != v:
+			fmt.Fprintf(w, "~ %s=%s -> %s\n", k, prev, v)
+		}
+	}
+
+	for _, e := range a {
+		if k := format(e.Key); !seen[k] {
+			if _, err := fmt.Fprintf(w, "- %s=%s\n", k, format(e.Value)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func filter(w io.Writer, entries []entry, pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return err
+	}
+
+	var werr error
+	lru, err := tenure.New(len(entries)+1, nil, tenure.WithChangeStream(w, func(err error) {
+		if werr == nil {
+			werr = err
+		}
+	}))
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if ok, _ := path.Match(pattern, format(e.Key)); ok {
+			lru.Put(e.Key, e.Value)
+		}
+	}
+
+	return werr
+}
+
+func convert(w io.Writer, entries []entry, to string) error {
+	switch to {
+	case "json":
+		if entries == nil {
+			entries = []entry{}
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"key", "value"})
+		for _, e := range entries {
+			cw.Write([]string{format(e.Key), format(e.Value)})
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("unknown format %q", to)
+	}
+}
+
+func load(addr string, entries []entry) error {
+	if addr == "" {
+		return errors.New("an -addr is required")
+	}
+
+	base := strings.TrimSuffix(addr, "/") + "/keys/"
+
+	for _, e := range entries {
+		req, err := http.NewRequest(http.MethodPut, base+url.PathEscape(format(e.Key)), strings.NewReader(format(e.Value)))
+		if err != nil {
+			return err
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		if res.StatusCode >= 300 {
+			return fmt.Errorf("storing %s: %s", format(e.Key), res.Status)
+		}
+	}
+
+	return nil
+}
+
+// format renders a decoded key or value as text: strings verbatim, anything else as JSON
+func format(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/httpapi"
+)
+
+// record writes the event log of the given mutations to a temporary snapshot file
+func record(t *testing.T, capacity int, mutate func(lru *tenure.LRUCache)) string {
+	var buf bytes.Buffer
+
+	lru, err := tenure.New(capacity, nil, tenure.WithChangeStream(&buf, nil))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	mutate(lru)
+
+	name := filepath.Join(t.TempDir(), "snapshot.ndjson")
+	if err := os.WriteFile(name, buf.Bytes(), 0o644); err != nil {
+		t.Fatalf("Failed to write snapshot; see %v", err)
+	}
+
+	return name
+}
+
+func exec(t *testing.T, args ...string) string {
+	var stdout, stderr bytes.Buffer
+
+	if code := run(args, strings.NewReader(""), &stdout, &stderr); code != 0 {
+		t.Fatalf("%v exited with %v; see %s", args, code, stderr.String())
+	}
+
+	return stdout.String()
+}
+
+func TestCommands(t *testing.T) {
+	a := record(t, 2, func(lru *tenure.LRUCache) {
+		lru.Put("user:1", "alice")
+		lru.Put("user:2", "bob")
+		lru.Put("user:3", "eve")
+	})
+
+	b := record(t, 4, func(lru *tenure.LRUCache) {
+		lru.Put("user:2", "bob")
+		lru.Put("user:3", "mallory")
+		lru.Put("item:1", 1)
+	})
+
+	if have, want := exec(t, "dump", a), "user:2=bob\nuser:3=eve\n"; have != want {
+		t.Fatalf("dump mismatch; Have %q, Want %q", have, want)
+	}
+
+	if have, want := exec(t, "diff", a, b), "~ user:3=eve -> mallory\n+ item:1=1\n"; have != want {
+		t.Fatalf("diff mismatch; Have %q, Want %q", have, want)
+	}
+
+	if have, want := exec(t, "convert", "-format", "csv", b), "key,value\nuser:2,bob\nuser:3,mallory\nitem:1,1\n"; have != want {
+		t.Fatalf("convert mismatch; Have %q, Want %q", have, want)
+	}
+
+	if have := exec(t, "convert", b); !strings.Contains(have, `"key": "item:1"`) {
+		t.Fatalf("convert mismatch; Have %q", have)
+	}
+
+	filtered := filepath.Join(t.TempDir(), "filtered.ndjson")
+	if err := os.WriteFile(filtered, []byte(exec(t, "filter", "-match", "user:*", b)), 0o644); err != nil {
+		t.Fatalf("Failed to write snapshot; see %v", err)
+	}
+
+	if have, want := exec(t, "dump", filtered), "user:2=bob\nuser:3=mallory\n"; have != want {
+		t.Fatalf("filter mismatch; Have %q, Want %q", have, want)
+	}
+
+	target, err := tenure.New(8, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	srv := httptest.NewServer(httpapi.New(target))
+	defer srv.Close()
+
+	exec(t, "load", "-addr", srv.URL, b)
+
+	if v, ok := target.Get("user:3"); !ok || v != "mallory" {
+		t.Fatalf("load mismatch; Have (%v, %v)", v, ok)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	if code := run([]string{"bogus"}, nil, &stdout, &stderr); code != 2 || !strings.Contains(stderr.String(), "usage") {
+		t.Fatalf("Unknown commands should report usage; Have %v", code)
+	}
+}
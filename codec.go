@@ -0,0 +1,55 @@
+package tenure
+
+// codec serializes values for storage; see WithCodec
+type codec struct {
+	enc func(value interface{}) ([]byte, error)
+	dec func(data []byte) (interface{}, error)
+}
+
+// WithCodec configures the cache to store values serialized by `enc`, decoding them by `dec` upon retrieval,
+// such that values may be held compactly (e.g. compressed) while transactions accept and return them as given
+// Each retrieval decodes anew, so that callers never share a value with the cache, nor with one another;
+// eviction callbacks, events, dumps and snapshots likewise receive decoded values
+// A value that fails to encode is not cached, and supersedes any prior entry for its key, which is removed:
+// TryPut returns the encoding error, without writing the value to the backing store; Put reports no eviction;
+// GetOrLoad returns the loaded value uncached. A value that fails to decode is reported as a miss, and surfaced
+// elsewhere as nil
+// Nil values and negative entries are stored as is, and memory budgets measure values as stored
+func WithCodec(enc func(value interface{}) ([]byte, error), dec func(data []byte) (interface{}, error)) Option {
+	return func(lc *LRUCache) {
+		lc.codec = &codec{enc, dec}
+	}
+}
+
+/* Utilities */
+
+// encode returns a value in the form in which it is to be stored, encoded where a codec is configured
+func (lc *LRUCache) encode(value interface{}) (interface{}, error) {
+	if lc.codec == nil || value == nil || value == NilValue {
+		return value, nil
+	}
+
+	return lc.codec.enc(value)
+}
+
+// decode returns a stored value in the form in which it was given, decoded where a codec is configured
+func (lc *LRUCache) decode(value interface{}) (interface{}, error) {
+	if lc.codec == nil {
+		return value, nil
+	}
+
+	if b, ok := value.([]byte); ok {
+		return lc.codec.dec(b)
+	}
+
+	return value, nil
+}
+
+// decoded returns a stored value as does decode, surfacing a value that fails to decode as nil
+func (lc *LRUCache) decoded(value interface{}) interface{} {
+	if v, err := lc.decode(value); err == nil {
+		return v
+	}
+
+	return nil
+}
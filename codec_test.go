@@ -0,0 +1,118 @@
+package tenure
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type codecValue struct {
+	Name string
+	Tags []string
+}
+
+func jsonCodec() Option {
+	return WithCodec(
+		func(value interface{}) ([]byte, error) {
+			if _, ok := value.(codecValue); !ok {
+				return nil, errors.New("unsupported value")
+			}
+			return json.Marshal(value)
+		},
+		func(data []byte) (interface{}, error) {
+			var v codecValue
+			err := json.Unmarshal(data, &v)
+			return v, err
+		},
+	)
+}
+
+func TestCodec(t *testing.T) {
+	var evicted interface{}
+
+	lru, err := New(1, func(key, value interface{}) {
+		evicted = value
+	}, jsonCodec())
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", codecValue{"a", []string{"x"}})
+
+	if _, ok := lru.cache["a"].value.([]byte); !ok {
+		t.Fatal("Values should be stored encoded")
+	}
+
+	v, ok := lru.Get("a")
+	if !ok || v.(codecValue).Name != "a" {
+		t.Fatalf("Get should return the decoded value; Have %v", v)
+	}
+
+	v.(codecValue).Tags[0] = "mutated"
+	if v := lru.Peek("a"); v.(codecValue).Tags[0] != "x" {
+		t.Fatal("Each retrieval should decode a value of its own")
+	}
+
+	if lru.Put("b", 42); lru.Has("b") || !lru.Has("a") {
+		t.Fatal("Values failing to encode should not be cached")
+	}
+
+	lru.Put("b", codecValue{Name: "b"})
+	if e, ok := evicted.(codecValue); !ok || e.Name != "a" {
+		t.Fatalf("Eviction callbacks should receive decoded values; Have %v", evicted)
+	}
+
+	lru.cache["b"].value = []byte("corrupt")
+	if _, ok := lru.Get("b"); ok {
+		t.Fatal("Values failing to decode should be reported as misses")
+	}
+}
+
+func TestCodecReadThrough(t *testing.T) {
+	store := newMapStore()
+	store.data["a"] = codecValue{Name: "a"}
+
+	lru, err := New(4, nil, jsonCodec(), WithStore(store))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if v, err := lru.GetOrLoad("a"); err != nil || v.(codecValue).Name != "a" {
+			t.Fatalf("GetOrLoad mismatch; Have %v, Want %v", v, "a")
+		}
+	}
+
+	if store.loads != 1 {
+		t.Fatalf("Decoded values should be served from the cache; Have %v loads, Want %v", store.loads, 1)
+	}
+}
+
+func TestCodecEncodeFailure(t *testing.T) {
+	store := newMapStore()
+
+	lru, err := New(4, nil, jsonCodec(), WithStore(store), WithWriteThrough())
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", codecValue{Name: "a"})
+
+	if _, err := lru.TryPut("a", "unencodable"); err == nil {
+		t.Fatal("TryPut should report the failure to encode")
+	}
+
+	if v, ok := store.get("a"); !ok || v.(codecValue).Name != "a" {
+		t.Fatalf("Values failing to encode should not be written through; Have %v", v)
+	}
+
+	if lru.Has("a") {
+		t.Fatal("Values failing to encode should supersede the prior entry")
+	}
+
+	lru.Put("b", codecValue{Name: "b"})
+	lru.Put("b", "unencodable")
+	if lru.Has("b") {
+		t.Fatal("Values failing to encode should supersede the prior entry")
+	}
+}
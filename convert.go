@@ -0,0 +1,65 @@
+package tenure
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ToMap returns the cache's entries as a plain map of keys to values, e.g. to interoperate with code expecting
+// maps. Keys are surfaced in stored form, as by Keys, save that byte slice keys are surfaced as strings, as a
+// map cannot be keyed by them; values are surfaced as Get would return them. Negative entries, and entries
+// past their hard expiry, are omitted. The cache's order of recency is not preserved; see Keys
+func (lc *LRUCache) ToMap() map[interface{}]interface{} {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	now := lc.clock.Now()
+	m := make(map[interface{}]interface{}, lc.links.Len())
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		if kv.negative || kv.expired(now) {
+			continue
+		}
+
+		key := kv.key
+		if ref, ok := key.(*KeyRef); ok {
+			if b, ok := ref.Key.([]byte); ok {
+				key = string(b)
+			}
+		}
+
+		m[key] = lc.decoded(kv.value)
+	}
+
+	return m
+}
+
+// Warm inserts the entries of `m` into the cache, enacting the eviction policy where they exceed its capacity
+// Map iteration is randomized, so entries are inserted in the order of their keys' default formats, per
+// fmt.Sprint, such that the resulting order of recency is deterministic: the greatest key is most recently-used
+// As with Receive, warmed entries are neither written to a backing Store nor published to an Invalidator;
+// values refused by the cache's nil policy are skipped. Returns the number of entries inserted
+func (lc *LRUCache) Warm(m map[interface{}]interface{}) (warmed int) {
+	type entry struct {
+		key   interface{}
+		order string
+	}
+
+	entries := make([]entry, 0, len(m))
+	for k := range m {
+		entries = append(entries, entry{k, fmt.Sprint(k)})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].order < entries[j].order })
+
+	for _, e := range entries {
+		value, err := lc.admit(m[e.key])
+		if err != nil {
+			continue
+		}
+
+		lc.insert(e.key, value, entrySpec{})
+		warmed++
+	}
+
+	return warmed
+}
@@ -0,0 +1,36 @@
+package tenure
+
+import "testing"
+
+func TestToMap(t *testing.T) {
+	lru, err := New(10, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put([]byte("b"), 2)
+	lru.PutNegative("c", 0)
+
+	m := lru.ToMap()
+	if len(m) != 2 || m["a"] != 1 || m["b"] != 2 {
+		t.Errorf("Map mismatch; Have %v, Want map[a:1 b:2]", m)
+	}
+}
+
+func TestWarm(t *testing.T) {
+	lru, err := New(3, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	n := lru.Warm(map[interface{}]interface{}{"d": 4, "b": 2, "a": 1, "c": 3})
+	if n != 4 {
+		t.Errorf("Warmed count mismatch; Have %v, Want %v", n, 4)
+	}
+
+	keys := lru.Keys()
+	if len(keys) != 3 || keys[0] != "b" || keys[1] != "c" || keys[2] != "d" {
+		t.Errorf("Warmed entries should be inserted in order of their keys; Have %v, Want [b c d]", keys)
+	}
+}
@@ -0,0 +1,93 @@
+package tenure
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTooLarge is returned where an entry is refused for exceeding the cache's maximum entry cost
+var ErrTooLarge = errors.New("entry exceeds the maximum entry cost of the cache")
+
+// WithMaxEntryCost configures the cache to refuse entries costing more than `max`, rather than evicting swathes
+// of the cache to admit a single outsized entry. Entries are costed per the Cost entry option, or else the
+// cache's Sizer or memory budget's Weigher; entries so costing nothing are never refused
+// A refused entry supersedes any prior entry for its key, which is removed. TryPut reports the refusal as
+// ErrTooLarge; Put, PutWithTTL and PutWith report no eviction; GetOrLoad returns the loaded value uncached
+func WithMaxEntryCost(max int64) Option {
+	return func(lc *LRUCache) {
+		lc.maxCost = max
+	}
+}
+
+// Cost declares the cost of the entry, in place of that computed by the cache's Sizer or memory budget's Weigher
+// See WithSizer, WithMaxEntryCost and WithMemoryBudget
+func Cost(n int64) EntryOption {
+	return func(spec *entrySpec) {
+		spec.cost, spec.costed = n, true
+	}
+}
+
+// TryPut adds or inserts a given key / value pair into the cache, as does PutWith, reporting why the entry was
+// refused, if so: ErrTooLarge per WithMaxEntryCost, ErrNilValue per WithNilPolicy, the error of a failed encoding
+// per WithCodec, or the error of a failed write to the backing store
+// The value is encoded before it is written to the backing store, such that a value the cache refuses is never
+// written through
+func (lc *LRUCache) TryPut(key, value interface{}, opts ...EntryOption) (wasEvicted bool, err error) {
+	if lc.latency != nil {
+		defer lc.latency.observe(latencyPut, time.Now())
+	}
+
+	var spec entrySpec
+	if len(opts) > 0 {
+		spec = specOf(opts)
+	}
+
+	if value, err = lc.admit(value); err != nil {
+		return false, err
+	}
+
+	spec.cost, spec.costed = lc.cost(key, value, spec), true
+	if lc.oversized(spec.cost) {
+		lc.remove(key)
+		return false, ErrTooLarge
+	}
+
+	encoded, err := lc.encode(value)
+	if err != nil {
+		lc.remove(key)
+		return false, err
+	}
+
+	if err = lc.persist(key, value); err != nil {
+		return false, err
+	}
+
+	defer lc.publish(key)
+	return lc.insertEncoded(key, value, encoded, spec), nil
+}
+
+/* Utilities */
+
+// specOf returns the specification configured by the given options
+// It is kept apart from its callers, as the specification escapes to the heap once passed to an option
+func specOf(opts []EntryOption) (spec entrySpec) {
+	for _, opt := range opts {
+		opt(&spec)
+	}
+
+	return
+}
+
+// cost returns the cost of a key / value pair, as declared in `spec` or else as weighed; see weigh
+func (lc *LRUCache) cost(key, value interface{}, spec entrySpec) int64 {
+	if spec.costed {
+		return spec.cost
+	}
+
+	return lc.weigh(key, value)
+}
+
+// oversized reports whether an entry of the given cost exceeds the maximum entry cost
+func (lc *LRUCache) oversized(cost int64) bool {
+	return lc.maxCost > 0 && cost > lc.maxCost
+}
@@ -0,0 +1,65 @@
+package tenure
+
+import "testing"
+
+func TestMaxEntryCost(t *testing.T) {
+	lru, err := New(8, nil, WithMaxEntryCost(10))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	if _, err := lru.TryPut("a", 1, Cost(10)); err != nil {
+		t.Fatalf("Entries within the maximum cost should be admitted; see %v", err)
+	}
+
+	if _, err := lru.TryPut("a", 2, Cost(11)); err != ErrTooLarge {
+		t.Fatalf("Error mismatch; Have %v, Want %v", err, ErrTooLarge)
+	}
+
+	if lru.Has("a") {
+		t.Fatal("A refused entry should supersede the prior entry for its key")
+	}
+
+	if lru.PutWith("b", 1, Cost(11)) || lru.Has("b") {
+		t.Fatal("PutWith should refuse entries exceeding the maximum cost")
+	}
+
+	if lru.Put("c", 1); !lru.Has("c") {
+		t.Fatal("Entries costing nothing should never be refused")
+	}
+
+	strict, err := New(8, nil, WithNilPolicy(NilReject))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	if _, err := strict.TryPut("a", nil); err != ErrNilValue {
+		t.Fatalf("Error mismatch; Have %v, Want %v", err, ErrNilValue)
+	}
+}
+
+func TestMaxEntryCostWeighed(t *testing.T) {
+	store := newMapStore()
+	store.data["big"] = "0123456789abcdef"
+
+	lru, err := New(8, nil, WithStore(store), WithMaxEntryCost(8), WithMemoryBudget(MemoryBudgetConfig{
+		Budget:  1 << 20,
+		Weigher: func(key, value interface{}) int64 { return int64(len(value.(string))) },
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	if _, err := lru.TryPut("small", "0123"); err != nil {
+		t.Fatalf("Entries within the maximum cost should be admitted; see %v", err)
+	}
+
+	if v, err := lru.GetOrLoad("big"); err != nil || v != store.data["big"] {
+		t.Fatalf("GetOrLoad should return an outsized value; Have %v, Want %v", v, store.data["big"])
+	}
+
+	if lru.Has("big") {
+		t.Fatal("Outsized loaded values should not be cached")
+	}
+}
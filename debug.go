@@ -0,0 +1,127 @@
+package tenure
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+)
+
+// Logger is the destination of diagnostic reports emitted by the cache; it is satisfied by *log.Logger
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithDebug enables an opt-in debug mode that detects common misuse at runtime and reports it to `logger`:
+// keys of non-comparable types; pointer, map or slice values mutated after Put, detected by comparing
+// checksums on a `sampleRate` fraction of hits; and eviction callbacks calling back into the cache,
+// which would otherwise deadlock. Reentrant calls are reported and then panic
+// Debug mode is costly and is not intended for production use; reported keys are redacted
+func WithDebug(logger Logger, sampleRate float64) Option {
+	return func(lc *LRUCache) {
+		if logger != nil {
+			lc.debug = &debugger{logger: logger, sampleRate: sampleRate}
+		}
+	}
+}
+
+/* Utilities */
+
+type debugger struct {
+	logger     Logger
+	sampleRate float64
+	// dispatcher is the ID of the goroutine currently dispatching an eviction callback, if any
+	dispatcher int64
+}
+
+func (d *debugger) lintKey(lc *LRUCache, key interface{}) {
+	if d == nil {
+		return
+	}
+
+	d.reentry(lc, "a keyed transaction", key)
+
+	if t := reflect.TypeOf(key); t != nil && !t.Comparable() && !lc.keys.handles(key) {
+		d.logger.Printf("tenure: key of non-comparable type %v cannot be cached", t)
+	}
+}
+
+func (d *debugger) reentry(lc *LRUCache, op string, key interface{}) {
+	if d == nil {
+		return
+	}
+
+	if id := atomic.LoadInt64(&d.dispatcher); id != 0 && id == goroutineID() {
+		if key != nil {
+			key, _ = lc.Redact(key, nil)
+		}
+
+		msg := fmt.Sprintf("tenure: eviction callback invoked %s (key %v) on its own cache, which would deadlock", op, key)
+		d.logger.Printf("%s", msg)
+		panic(msg)
+	}
+}
+
+// dispatching marks the calling goroutine as dispatching a callback, returning a func that unmarks it
+func (d *debugger) dispatching() func() {
+	if d == nil {
+		return func() {}
+	}
+
+	atomic.StoreInt64(&d.dispatcher, goroutineID())
+	return func() { atomic.StoreInt64(&d.dispatcher, 0) }
+}
+
+func (d *debugger) checksum(value interface{}) uint64 {
+	if d == nil {
+		return 0
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+	default:
+		return 0
+	}
+
+	h := fnv.New64a()
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		fmt.Fprintf(h, "%#v", v.Elem().Interface())
+	} else {
+		fmt.Fprintf(h, "%#v", value)
+	}
+
+	return h.Sum64()
+}
+
+func (d *debugger) verify(lc *LRUCache, kv *pair) {
+	if d == nil || kv.checksum == 0 || rand.Float64() >= d.sampleRate {
+		return
+	}
+
+	if d.checksum(kv.value) != kv.checksum {
+		key, _ := lc.Redact(kv.key, nil)
+		d.logger.Printf("tenure: value for key %v was mutated after being cached", key)
+
+		// Report each mutation once
+		kv.checksum = d.checksum(kv.value)
+	}
+}
+
+func goroutineID() int64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+
+	// The stack trace is headed by "goroutine <id> [<state>]:"
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i > 0 {
+		b = b[:i]
+	}
+
+	id, _ := strconv.ParseInt(string(b), 10, 64)
+	return id
+}
@@ -0,0 +1,98 @@
+package tenure
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (rl *recordingLogger) Printf(format string, v ...interface{}) {
+	rl.lines = append(rl.lines, fmt.Sprintf(format, v...))
+}
+
+func (rl *recordingLogger) contains(substr string) bool {
+	for _, l := range rl.lines {
+		if strings.Contains(l, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDebugDetectsMutation(t *testing.T) {
+	logger := &recordingLogger{}
+
+	lru, err := New(4, nil, WithDebug(logger, 1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	v := &struct{ N int }{1}
+	lru.Put("a", v)
+	lru.Get("a")
+
+	if len(logger.lines) != 0 {
+		t.Fatalf("Unexpected report; Have %v", logger.lines)
+	}
+
+	v.N = 2
+	lru.Get("a")
+
+	if !logger.contains("mutated") {
+		t.Fatalf("Expected a mutation report; Have %v", logger.lines)
+	}
+}
+
+func TestDebugDetectsNonComparableKeys(t *testing.T) {
+	logger := &recordingLogger{}
+
+	lru, err := New(4, nil, WithDebug(logger, 1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	func() {
+		defer func() { recover() }()
+		lru.Put([]int{1}, 1)
+	}()
+
+	if !logger.contains("non-comparable") {
+		t.Fatalf("Expected a non-comparable key report; Have %v", logger.lines)
+	}
+}
+
+func TestDebugDetectsReentrantCallbacks(t *testing.T) {
+	logger := &recordingLogger{}
+
+	var lru *LRUCache
+	lru, err := New(1, func(k, v interface{}) {
+		lru.Has(k)
+	}, WithDebug(logger, 1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("Expected a reentrant callback to panic")
+			}
+		}()
+		lru.Put("b", 2)
+	}()
+
+	if !logger.contains("deadlock") {
+		t.Fatalf("Expected a reentrancy report; Have %v", logger.lines)
+	}
+
+	// The cache must remain usable
+	if !lru.Has("b") {
+		t.Fatal("Cache should remain usable after a reported reentrant callback")
+	}
+}
@@ -0,0 +1,60 @@
+package tenure
+
+import "strings"
+
+// DeleteFunc deletes every entry for which `pred` returns true, returning the number deleted
+// Entries are matched and deleted under a single acquisition of the lock, such that bulk invalidation neither
+// contends with concurrent transactions once per key, nor races entries inserted between listing and deletion
+// `pred` is invoked under the lock with keys in stored form, as surfaced by Keys, and must not call back into
+// the cache. As with Del, deletions are written to the backing Store and published to peers, unless the cache
+// is configured WithKeyHashing, whose stored keys cannot be mapped back to the keys of the Store or of peers
+func (lc *LRUCache) DeleteFunc(pred func(key, value interface{}) bool) (numDeleted int) {
+	lc.debug.reentry(lc, "DeleteFunc", nil)
+
+	var deleted []interface{}
+
+	lc.lock.Lock()
+	for kv := lc.links.Back(); kv != nil; {
+		prev := kv.Prev()
+
+		if pred(kv.key, lc.decoded(kv.value)) {
+			deleted = append(deleted, rawKey(kv.key))
+			lc.purgeLRUItem(kv)
+			lc.emit(EventDelete, kv)
+			lc.release(kv)
+		}
+
+		kv = prev
+	}
+	lc.maybeCompact()
+	lc.lock.Unlock()
+
+	if lc.keySecret == nil {
+		for _, key := range deleted {
+			lc.unpersist(key)
+			lc.publish(key)
+		}
+	}
+
+	return len(deleted)
+}
+
+// DeletePrefix deletes every entry whose key is a string bearing the given prefix, returning the number deleted
+// See DeleteFunc; keys of other types are never deleted, nor are any keys of a cache configured WithKeyHashing
+func (lc *LRUCache) DeletePrefix(prefix string) (numDeleted int) {
+	return lc.DeleteFunc(func(key, value interface{}) bool {
+		s, ok := key.(string)
+		return ok && strings.HasPrefix(s, prefix)
+	})
+}
+
+/* Utilities */
+
+// rawKey returns the key as given by the caller, for a key in stored form, unless hashed
+func rawKey(key interface{}) interface{} {
+	if ref, ok := key.(*KeyRef); ok {
+		return ref.Key
+	}
+
+	return key
+}
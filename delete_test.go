@@ -0,0 +1,65 @@
+package tenure
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDeleteFunc(t *testing.T) {
+	lru, err := New(10, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 6; i++ {
+		lru.Put(i, i*10)
+	}
+
+	n := lru.DeleteFunc(func(key, value interface{}) bool { return value.(int) >= 30 })
+	if n != 3 || lru.Size() != 3 {
+		t.Fatalf("Deleted count mismatch; Have (deleted=%v, size=%v), Want (deleted=3, size=3)", n, lru.Size())
+	}
+
+	for i := 0; i < 6; i++ {
+		if lru.Has(i) != (i < 3) {
+			t.Errorf("Key %v presence mismatch; Have %v, Want %v", i, lru.Has(i), i < 3)
+		}
+	}
+}
+
+func TestDeletePrefix(t *testing.T) {
+	var deleted []interface{}
+	store := newMapStore()
+	sink := withSink(func(e Event) {
+		if e.Op == EventDelete {
+			deleted = append(deleted, e.Key)
+		}
+	})
+
+	lru, err := New(10, nil, WithStore(store), WithWriteThrough(), sink)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		lru.Put(fmt.Sprintf("user:%d", i), i)
+		lru.Put(fmt.Sprintf("post:%d", i), i)
+	}
+	lru.Put(42, 42)
+
+	if n := lru.DeletePrefix("user:"); n != 3 {
+		t.Fatalf("Deleted count mismatch; Have %v, Want %v", n, 3)
+	}
+
+	if lru.Has("user:0") || !lru.Has("post:0") || !lru.Has(42) {
+		t.Fatalf("Only keys bearing the prefix should be deleted; Have %v", lru.Keys())
+	}
+
+	if _, err := store.Load("user:1"); err == nil {
+		t.Error("Deletions should be written through to the backing Store")
+	}
+
+	if len(deleted) != 3 {
+		t.Errorf("Each deletion should emit an event; Have %v", deleted)
+	}
+}
@@ -0,0 +1,64 @@
+package tenure
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// EarlyExpirationConfig configures probabilistic early expiration; see WithEarlyExpiration
+type EarlyExpirationConfig struct {
+	// Beta scales the eagerness of early expiration; 1 is optimal under the XFetch model, greater values favour
+	// earlier recomputation. Defaults to 1
+	Beta float64
+	// Delta is the presumed cost of recomputing a value, for entries whose cost has not been measured, i.e. those
+	// not loaded from the Store; if zero, such entries never expire early
+	Delta time.Duration
+}
+
+// WithEarlyExpiration configures the cache to treat reads of entries nearing their hard expiry as misses, with
+// a probability that rises as expiry approaches, so that recomputations of a hot entry are spread out ahead of
+// its expiry rather than stampeding upon it. This is the XFetch algorithm: a read at `now` is a miss where
+// now - Delta * Beta * ln(rand()) >= expiry, Delta being the time taken to recompute the value
+// The cost of loading each value from the Store is measured, and used as its Delta. Early expiration applies to
+// Get and GetOrLoad alone; the entry remains in the cache, and continues to be served by other means, until it
+// is superseded or expires. Should the Store fail to reload an entry expired early, GetOrLoad serves the entry
+func WithEarlyExpiration(cfg EarlyExpirationConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.Beta <= 0 {
+			cfg.Beta = 1
+		}
+
+		lc.early = &cfg
+	}
+}
+
+/* Utilities */
+
+// expiresEarly reports whether a read of `p` at present should be treated as a miss; see WithEarlyExpiration
+func (lc *LRUCache) expiresEarly(p *pair) bool {
+	if lc.early == nil || p.hardExpiry.IsZero() {
+		return false
+	}
+
+	delta := p.delta
+	if delta == 0 {
+		delta = lc.early.Delta
+	}
+
+	if delta <= 0 {
+		return false
+	}
+
+	// 1-Float64 lies in (0, 1], so its logarithm is finite and non-positive
+	gap := time.Duration(float64(delta) * lc.early.Beta * -math.Log(1-rand.Float64()))
+	if lc.clock.Now().Add(gap).Before(p.hardExpiry) {
+		return false
+	}
+
+	lc.lock.Lock()
+	lc.stats.EarlyExpirations++
+	lc.lock.Unlock()
+
+	return true
+}
@@ -0,0 +1,91 @@
+package tenure
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestEarlyExpiration(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(4, nil, WithClock(clock), WithEarlyExpiration(EarlyExpirationConfig{Delta: time.Second}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithTTL("a", 1, 0, time.Hour)
+	lru.Put("b", 2)
+
+	for i := 0; i < 1000; i++ {
+		if _, ok := lru.Get("a"); !ok {
+			t.Fatal("Entries far from expiry should not expire early")
+		}
+
+		if _, ok := lru.Get("b"); !ok {
+			t.Fatal("Entries bearing no expiry should never expire early")
+		}
+	}
+
+	// With a second remaining and a Delta of a second, reads miss with probability 1/e
+	clock.Advance(time.Hour - time.Second)
+
+	misses := 0
+	for i := 0; i < 1000; i++ {
+		if _, ok := lru.Get("a"); !ok {
+			misses++
+		}
+	}
+
+	if misses < 250 || misses > 500 {
+		t.Fatalf("Early expiration rate mismatch; Have %v misses of 1000, Want ~368", misses)
+	}
+
+	if s := lru.Stats(); s.EarlyExpirations != uint64(misses) {
+		t.Fatalf("Stats mismatch; Have %v, Want %v", s.EarlyExpirations, misses)
+	}
+
+	if v := lru.Peek("a"); v != 1 {
+		t.Fatalf("Entries expired early should remain in the cache; Have %v, Want %v", v, 1)
+	}
+}
+
+func TestEarlyExpirationReloads(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	ms := newMapStore()
+
+	lru, err := New(4, nil, WithClock(clock), WithStore(ms), WithEarlyExpiration(EarlyExpirationConfig{Delta: time.Hour}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithTTL("a", 1, 0, time.Hour)
+	ms.Write("a", 2)
+	clock.Advance(time.Hour - time.Nanosecond)
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 2 || ms.loads != 1 {
+		t.Fatalf("Entries on the brink of expiry should be reloaded; Have (%v, %v, loads=%v), Want (2, <nil>, loads=1)", v, err, ms.loads)
+	}
+
+	if info, _ := lru.Inspect("a"); !info.HardExpiry.IsZero() {
+		t.Fatal("Reloaded entries should be stamped afresh")
+	}
+}
+
+func TestEarlyExpirationFallback(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(4, nil, WithClock(clock), WithStore(failingStore{errors.New("unavailable")}), WithEarlyExpiration(EarlyExpirationConfig{Delta: time.Hour}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithTTL("a", 1, 0, time.Hour)
+	clock.Advance(time.Hour - time.Nanosecond)
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 1 {
+		t.Fatalf("Entries expired early should be served should their reload fail; Have (%v, %v), Want (1, <nil>)", v, err)
+	}
+}
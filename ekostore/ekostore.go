@@ -0,0 +1,132 @@
+// Package ekostore adapts a tenure cache to the store interface of eko/gocache, such that tenure may back the
+// caches, chains and loadables of frameworks built upon that abstraction
+// The package mirrors the library's option types rather than importing them; a shim converting the library's
+// options into those of this package suffices to register a Store with the library
+package ekostore
+
+import (
+	"context"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// StoreType is the type reported by GetType
+const StoreType = "tenure"
+
+// Options configures an item upon Set
+type Options struct {
+	// Cost is the cost of the item; see tenure.WithMaxCost
+	Cost int64
+	// Expiration is the lifetime of the item; zero designates an item that never expires
+	Expiration time.Duration
+	// Tags are the tags by which the item may be invalidated; see Invalidate
+	Tags []string
+}
+
+// InvalidateOptions designates the items to invalidate
+type InvalidateOptions struct {
+	Tags []string
+}
+
+// Store exposes a tenure cache via the store interface of eko/gocache
+type Store struct {
+	lru *tenure.LRUCache
+}
+
+// tagged is the form in which the Store holds values, bearing their tags, such that invalidation by tag
+// requires no index that could outlive the items themselves
+type tagged struct {
+	value interface{}
+	tags  []string
+}
+
+// New adapts an extant tenure cache; values must be held by the Store alone, as they are stored alongside
+// their tags
+func New(lru *tenure.LRUCache) *Store {
+	return &Store{lru: lru}
+}
+
+// Get returns the value for the key, or tenure.ErrNotFound if not extant
+func (s *Store) Get(ctx context.Context, key interface{}) (interface{}, error) {
+	v, ok := s.lru.Get(key)
+	if !ok {
+		return nil, tenure.ErrNotFound
+	}
+
+	return v.(*tagged).value, nil
+}
+
+// GetWithTTL returns the value for the key, as does Get, along with the time remaining until its expiry, which
+// is zero where the item never expires
+func (s *Store) GetWithTTL(ctx context.Context, key interface{}) (interface{}, time.Duration, error) {
+	v, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ttl, _ := s.lru.TTL(key)
+	if ttl == tenure.NoExpiry {
+		ttl = 0
+	}
+
+	return v, ttl, nil
+}
+
+// Set adds or replaces the value for the key per the given options, which may be nil
+func (s *Store) Set(ctx context.Context, key, value interface{}, options *Options) error {
+	if options == nil {
+		options = &Options{}
+	}
+
+	opts := []tenure.EntryOption{tenure.TTL(0, options.Expiration)}
+	if options.Cost > 0 {
+		opts = append(opts, tenure.Cost(options.Cost))
+	}
+
+	_, err := s.lru.TryPut(key, &tagged{value, append([]string(nil), options.Tags...)}, opts...)
+	return err
+}
+
+// Delete deletes the value for the key, if extant
+func (s *Store) Delete(ctx context.Context, key interface{}) error {
+	s.lru.Del(key)
+	return nil
+}
+
+// Invalidate deletes every item bearing any of the given tags
+func (s *Store) Invalidate(ctx context.Context, options InvalidateOptions) error {
+	if len(options.Tags) == 0 {
+		return nil
+	}
+
+	s.lru.DeleteFunc(func(key, value interface{}) bool {
+		t, ok := value.(*tagged)
+		if !ok {
+			return false
+		}
+
+		for _, have := range t.tags {
+			for _, want := range options.Tags {
+				if have == want {
+					return true
+				}
+			}
+		}
+
+		return false
+	})
+
+	return nil
+}
+
+// Clear deletes every item
+func (s *Store) Clear(ctx context.Context) error {
+	s.lru.Drop()
+	return nil
+}
+
+// GetType returns the type of the store
+func (s *Store) GetType() string {
+	return StoreType
+}
@@ -0,0 +1,49 @@
+package ekostore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+func TestStore(t *testing.T) {
+	lru, err := tenure.New(10, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	ctx := context.Background()
+	s := New(lru)
+
+	s.Set(ctx, "a", 1, &Options{Expiration: time.Hour, Tags: []string{"users"}})
+	s.Set(ctx, "b", 2, &Options{Tags: []string{"posts"}})
+	s.Set(ctx, "c", 3, nil)
+
+	if v, ttl, err := s.GetWithTTL(ctx, "a"); err != nil || v != 1 || ttl <= 0 || ttl > time.Hour {
+		t.Errorf("GetWithTTL mismatch; Have (%v, %v, %v), Want (1, ~1h, nil)", v, ttl, err)
+	}
+
+	if _, ttl, _ := s.GetWithTTL(ctx, "c"); ttl != 0 {
+		t.Errorf("Items without expiration should report a TTL of zero; Have %v", ttl)
+	}
+
+	if err := s.Invalidate(ctx, InvalidateOptions{Tags: []string{"users"}}); err != nil {
+		t.Fatalf("Failed to invalidate; see %v", err)
+	}
+
+	if _, err := s.Get(ctx, "a"); err != tenure.ErrNotFound {
+		t.Errorf("Invalidated items should be absent; Have %v", err)
+	}
+
+	if v, err := s.Get(ctx, "b"); err != nil || v != 2 {
+		t.Errorf("Items bearing other tags should remain; Have (%v, %v)", v, err)
+	}
+
+	s.Delete(ctx, "b")
+	s.Clear(ctx)
+	if lru.Size() != 0 || s.GetType() != StoreType {
+		t.Errorf("Clear should delete every item; Have %v", lru.Keys())
+	}
+}
@@ -0,0 +1,138 @@
+package tenure
+
+import "time"
+
+// EntryOption configures an individual entry upon insertion via PutWith
+type EntryOption func(*entrySpec)
+
+type entrySpec struct {
+	soft       time.Duration
+	hard       time.Duration
+	provenance []byte
+	refresh    time.Duration
+	mode       ExpirationMode
+	delta      time.Duration
+	cost       int64
+	priority   int
+	// costed reports whether cost is declared, rather than to be weighed; see Cost
+	costed bool
+	// noEvict defers enactment of the eviction policy to the caller
+	noEvict bool
+	// negative marks the entry as a cached "not found"; see PutNegative
+	negative bool
+	// ifCurrent, if set, confines the insertion to updating that very revision of the entry, or to inserting one
+	// where it is the revision of no entry; else it is discarded
+	ifCurrent *revision
+	// merge, if set, resolves an extant entry for the key per the given strategy; see Merge
+	merge MergeStrategy
+	// onlyExtant confines the insertion to updating an extant entry, which must be neither expired nor negative
+	onlyExtant bool
+	// outcome, if set, receives the outcome of the insertion
+	outcome *insertOutcome
+	// restore, if set, reinstates the entry's timestamps verbatim, in place of stamping them afresh
+	restore *timestamps
+}
+
+// revision identifies an entry as of a given stamping, so that it may be updated only if untouched since
+type revision struct {
+	p   *pair
+	gen uint64
+}
+
+// insertOutcome reports the outcome of an insertion; see entrySpec
+type insertOutcome struct {
+	// written reports whether the entry was inserted or updated, rather than discarded
+	written bool
+	// conflict reports whether the insertion was discarded for the entry's departing from spec.ifCurrent
+	conflict bool
+	// existed reports whether an entry, neither expired nor negative, was extant for the key, and old its
+	// value in stored form
+	existed bool
+	old     interface{}
+}
+
+type timestamps struct {
+	created    time.Time
+	softExpiry time.Time
+	hardExpiry time.Time
+}
+
+// TTL stamps the entry with soft and hard TTLs; see PutWithTTL
+func TTL(soft, hard time.Duration) EntryOption {
+	return func(spec *entrySpec) {
+		spec.soft, spec.hard = soft, hard
+	}
+}
+
+// RefreshAfter marks the entry as due a background refresh from the Store once `d` has elapsed; see WithRefreshAhead
+// It has no effect on a cache configured with neither WithRefreshAhead nor WithStaleWhileRevalidate
+func RefreshAfter(d time.Duration) EntryOption {
+	return func(spec *entrySpec) {
+		spec.refresh = d
+	}
+}
+
+// Provenance attaches an opaque provenance blob to the entry, e.g. its source system, a query hash or a trace ID,
+// so that stale data can be traced back to its producer. Provenance is surfaced by Inspect and in events
+func Provenance(blob []byte) EntryOption {
+	return func(spec *entrySpec) {
+		spec.provenance = append([]byte(nil), blob...)
+	}
+}
+
+// EntryInfo describes an entry and its metadata
+type EntryInfo struct {
+	Key        interface{}
+	Value      interface{}
+	Created    time.Time
+	SoftExpiry time.Time
+	HardExpiry time.Time
+	Hits       uint64
+	Provenance []byte
+	// Priority is the entry's eviction priority; see PutWithPriority
+	Priority int
+	// Negative reports whether the entry is a cached "not found"; see PutNegative
+	Negative bool
+}
+
+// PutWith adds or inserts a given key / value pair into the cache, as does Put, configured by the given options
+// Refused entries report no eviction; see TryPut
+func (lc *LRUCache) PutWith(key, value interface{}, opts ...EntryOption) (wasEvicted bool) {
+	wasEvicted, _ = lc.TryPut(key, value, opts...)
+	return
+}
+
+// Inspect returns the entry for the given key and its metadata, without enacting the eviction policy
+// The boolean flag is false if the key is not extant, or is past its hard expiry
+func (lc *LRUCache) Inspect(key interface{}) (info EntryInfo, ok bool) {
+	key = lc.mapKey(key)
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	kv, ok := lc.cache[key]
+	if !ok {
+		return EntryInfo{}, false
+	}
+
+	if kv.expired(lc.clock.Now()) {
+		return EntryInfo{}, false
+	}
+
+	return lc.info(kv), true
+}
+
+// info describes the entry; the read lock must be held
+func (lc *LRUCache) info(kv *pair) EntryInfo {
+	return EntryInfo{
+		Key:        kv.key,
+		Value:      lc.decoded(kv.value),
+		Created:    kv.created,
+		SoftExpiry: kv.softExpiry,
+		HardExpiry: kv.hardExpiry,
+		Hits:       kv.hits,
+		Provenance: kv.provenance,
+		Priority:   kv.priority,
+		Negative:   kv.negative,
+	}
+}
@@ -0,0 +1,38 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestProvenance(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	ch := make(chan Event, 1)
+
+	lru, err := New(4, nil, WithClock(clock), WithChangeChannel(ch))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWith("a", 1, Provenance([]byte("orders-db:q=42")), TTL(0, time.Minute))
+
+	info, ok := lru.Inspect("a")
+	if !ok || string(info.Provenance) != "orders-db:q=42" {
+		t.Fatalf("Provenance mismatch; Have %q", info.Provenance)
+	}
+
+	if !info.HardExpiry.Equal(time.Unix(60, 0)) || !info.Created.Equal(time.Unix(0, 0)) {
+		t.Fatalf("Entry metadata mismatch; Have %+v", info)
+	}
+
+	if e := <-ch; string(e.Provenance) != "orders-db:q=42" {
+		t.Fatalf("Event provenance mismatch; Have %q", e.Provenance)
+	}
+
+	lru.Put("a", 2)
+	if info, _ := lru.Inspect("a"); info.Provenance != nil {
+		t.Fatalf("Put should clear provenance; Have %q", info.Provenance)
+	}
+}
@@ -0,0 +1,154 @@
+package tenure
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// EventOp designates the kind of mutation described by an Event
+type EventOp int
+
+const (
+	// EventPut records the insertion of a new entry
+	EventPut EventOp = iota + 1
+	// EventUpdate records the replacement of an extant entry's value
+	EventUpdate
+	// EventDelete records the explicit deletion of an entry
+	EventDelete
+	// EventEvict records the removal of an entry by the eviction policy, or by Drop
+	EventEvict
+	// EventExpire records the removal of an entry upon its hard expiry
+	EventExpire
+	// EventResize records an adjustment of the cache's capacity by the auto-tuner; its Value is the capacity
+	// adopted, and it bears no Key; see WithAutoTune
+	EventResize
+)
+
+var eventOpNames = map[EventOp]string{
+	EventPut:    "put",
+	EventUpdate: "update",
+	EventDelete: "delete",
+	EventEvict:  "evict",
+	EventExpire: "expire",
+	EventResize: "resize",
+}
+
+// String returns the lowercase name of the operation
+func (op EventOp) String() string {
+	return eventOpNames[op]
+}
+
+// MarshalText encodes the operation as its name
+func (op EventOp) MarshalText() ([]byte, error) {
+	return []byte(op.String()), nil
+}
+
+// UnmarshalText decodes an operation from its name
+func (op *EventOp) UnmarshalText(b []byte) error {
+	for o, name := range eventOpNames {
+		if name == string(b) {
+			*op = o
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown event op %q", b)
+}
+
+// Event describes a single mutation of the cache
+// Events are assigned strictly increasing sequence numbers in the order the mutations occurred
+// Keys are reported in stored form, and keys and values are redacted where a Redactor is configured
+type Event struct {
+	Seq   uint64      `json:"seq"`
+	Op    EventOp     `json:"op"`
+	Key   interface{} `json:"key"`
+	Value interface{} `json:"value,omitempty"`
+	Time  time.Time   `json:"time"`
+	// Provenance is the provenance attached to the entry at Put, if any
+	Provenance []byte `json:"provenance,omitempty"`
+	// SoftExpiry and HardExpiry are the expiries of the entry put or updated, if it bears any; see PutWithTTL
+	SoftExpiry *time.Time `json:"softExpiry,omitempty"`
+	HardExpiry *time.Time `json:"hardExpiry,omitempty"`
+}
+
+// WithChangeStream configures the cache to write every mutation to `w` as a newline-delimited JSON Event,
+// suitable for replaying into a replica cache or for an audit log
+// Events are written synchronously, in order, while the cache is locked; `w` should therefore be buffered
+// Write errors are reported to `onError`, if non-nil
+func WithChangeStream(w io.Writer, onError func(err error)) Option {
+	enc := json.NewEncoder(w)
+
+	return withSink(func(e Event) {
+		if err := enc.Encode(e); err != nil && onError != nil {
+			onError(err)
+		}
+	})
+}
+
+// WithChangeChannel configures the cache to send every mutation to `ch` as an Event
+// Sends are performed synchronously, in order, while the cache is locked; a full channel therefore
+// blocks every writer until it is drained, ensuring no event is ever dropped
+func WithChangeChannel(ch chan<- Event) Option {
+	return withSink(func(e Event) {
+		ch <- e
+	})
+}
+
+/* Utilities */
+
+func withSink(sink func(Event)) Option {
+	return func(lc *LRUCache) {
+		lc.sinks = append(lc.sinks, sink)
+	}
+}
+
+// emit dispatches an Event describing a mutation of `p` to every sink, and to the watchers of its key
+// The write lock must be held
+func (lc *LRUCache) emit(op EventOp, p *pair) {
+	if len(lc.sinks) == 0 && len(lc.watchers) == 0 {
+		return
+	}
+
+	lc.seq++
+	e := Event{Seq: lc.seq, Op: op, Time: lc.clock.Now(), Provenance: p.provenance}
+	e.Key, e.Value = lc.Redact(p.key, lc.decoded(p.value))
+
+	if op == EventDelete || op == EventEvict || op == EventExpire {
+		e.Value = nil
+	} else {
+		e.SoftExpiry, e.HardExpiry = timeOrNil(p.softExpiry), timeOrNil(p.hardExpiry)
+	}
+
+	for _, sink := range lc.sinks {
+		sink(e)
+	}
+
+	if len(lc.watchers) > 0 {
+		lc.notify(p.key, e)
+	}
+}
+
+// timeOrNil returns a pointer to `t`, or nil where it is the zero time
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// emitResize dispatches an EventResize for the adoption of `capacity` to every sink; the write lock must be held
+func (lc *LRUCache) emitResize(capacity int) {
+	if len(lc.sinks) == 0 {
+		return
+	}
+
+	lc.seq++
+	e := Event{Seq: lc.seq, Op: EventResize, Value: capacity, Time: lc.clock.Now()}
+
+	for _, sink := range lc.sinks {
+		sink(e)
+	}
+}
@@ -0,0 +1,58 @@
+package tenure
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestChangeChannel(t *testing.T) {
+	ch := make(chan Event, 16)
+
+	lru, err := New(1, nil, WithChangeChannel(ch))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("a", 2)
+	lru.Put("b", 3)
+	lru.Del("b")
+	close(ch)
+
+	want := []EventOp{EventPut, EventUpdate, EventPut, EventEvict, EventDelete}
+
+	var i int
+	for e := range ch {
+		if e.Op != want[i] || e.Seq != uint64(i+1) {
+			t.Fatalf("Event mismatch at %v; Have (%v, seq %v), Want (%v, seq %v)", i, e.Op, e.Seq, want[i], i+1)
+		}
+		i++
+	}
+
+	if i != len(want) {
+		t.Fatalf("Event count mismatch; Have %v, Want %v", i, len(want))
+	}
+}
+
+func TestChangeStream(t *testing.T) {
+	var buf bytes.Buffer
+
+	lru, err := New(4, nil, WithChangeStream(&buf, nil), WithRedactor(func(k, v interface{}) (interface{}, interface{}) {
+		return k, "***"
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", "secret")
+
+	var e Event
+	if err := json.NewDecoder(&buf).Decode(&e); err != nil {
+		t.Fatalf("Failed to decode event; see %v", err)
+	}
+
+	if e.Op != EventPut || e.Key != "a" || e.Value != "***" {
+		t.Fatalf("Event mismatch; Have %+v", e)
+	}
+}
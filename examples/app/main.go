@@ -0,0 +1,288 @@
+// Command app is an end-to-end example of a service fronting a slow origin with a tenure cache, wiring together:
+//
+//   - a read-through Store over the origin, written through upon updates
+//   - soft and hard TTLs upon cached items
+//   - usage statistics, served at /metrics
+//   - snapshot persistence, by way of a change stream replayed upon startup
+//   - response caching middleware, invalidated upon updates
+//   - the admin API, served under /admin/
+//
+// Usage:
+//
+//	app -addr :8080 -snapshot app.ndjson
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/httpapi"
+	"github.com/MatthewZito/tenure-go/httpcache"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "`address` to listen on")
+	snapshot := flag.String("snapshot", "app.ndjson", "`path` of the snapshot file")
+	flag.Parse()
+
+	a, err := newApp(config{Capacity: 1024, Snapshot: *snapshot, Origin: newOrigin(50 * time.Millisecond)})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer a.Close()
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, a))
+}
+
+// config configures an app
+type config struct {
+	Capacity int
+	// Snapshot is the path of the snapshot file; it is replayed upon startup, and records every mutation thereafter
+	Snapshot string
+	Origin   *origin
+	// Clock governs TTLs; it defaults to the system clock
+	Clock tenure.Clock
+}
+
+// app is an http.Handler serving items from a cache over the origin
+type app struct {
+	cache    *tenure.LRUCache
+	origin   *origin
+	pages    *httpcache.Cache
+	mux      *http.ServeMux
+	log      *os.File
+	buf      *bufio.Writer
+	logMu    sync.Mutex
+	logError error
+}
+
+func newApp(cfg config) (*app, error) {
+	a := &app{origin: cfg.Origin, mux: http.NewServeMux()}
+
+	// entries persisted by a prior run are replayed into a scratch cache, then handed to the live cache,
+	// whose change stream thereby records a compacted snapshot in place of the prior run's full history
+	scratch, err := tenure.New(cfg.Capacity, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if b, err := ioutil.ReadFile(cfg.Snapshot); err == nil {
+		if _, err := scratch.Replay(bytes.NewReader(b)); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if a.log, err = os.Create(cfg.Snapshot + ".tmp"); err != nil {
+		return nil, err
+	}
+	a.buf = bufio.NewWriter(a.log)
+
+	opts := []tenure.Option{
+		tenure.WithStore(cfg.Origin),
+		tenure.WithWriteThrough(),
+		tenure.WithChangeStream(lockedWriter{a}, func(err error) {
+			a.logMu.Lock()
+			a.logError = err
+			a.logMu.Unlock()
+		}),
+	}
+	if cfg.Clock != nil {
+		opts = append(opts, tenure.WithClock(cfg.Clock))
+	}
+
+	if a.cache, err = tenure.New(cfg.Capacity, nil, opts...); err != nil {
+		return nil, err
+	}
+
+	var handoff bytes.Buffer
+	if err := scratch.Handoff(&handoff); err != nil {
+		return nil, err
+	}
+
+	if _, err := a.cache.Receive(&handoff); err != nil {
+		return nil, err
+	}
+
+	if err := a.flush(); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(cfg.Snapshot+".tmp", cfg.Snapshot); err != nil {
+		return nil, err
+	}
+
+	// rendered pages are cached apart from items, and so are neither persisted nor written to the origin
+	var pageOpts []tenure.Option
+	if cfg.Clock != nil {
+		pageOpts = append(pageOpts, tenure.WithClock(cfg.Clock))
+	}
+
+	pages, err := tenure.New(cfg.Capacity/4+1, nil, pageOpts...)
+	if err != nil {
+		return nil, err
+	}
+	a.pages = httpcache.New(pages, httpcache.Config{Routes: map[string]time.Duration{"/items/": time.Minute}})
+
+	a.mux.Handle("/items/", a.pages.Handler(http.HandlerFunc(a.item)))
+	a.mux.Handle("/admin/", http.StripPrefix("/admin", httpapi.New(a.cache)))
+	a.mux.HandleFunc("/metrics", a.metrics)
+
+	return a, nil
+}
+
+// ServeHTTP dispatches the request to the appropriate endpoint
+func (a *app) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mux.ServeHTTP(w, r)
+}
+
+// Close flushes the snapshot file and closes the cache
+func (a *app) Close() error {
+	err := a.cache.Close()
+
+	if ferr := a.flush(); ferr != nil && err == nil {
+		err = ferr
+	}
+
+	if cerr := a.log.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+func (a *app) item(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/items/")
+	if id == "" || strings.Contains(id, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		v, err := a.cache.GetOrLoad(id)
+		if err == tenure.ErrNotFound {
+			http.NotFound(w, r)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": id, "value": v})
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, 1<<20))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		// items are served for up to an hour, and flagged for refresh after ten minutes
+		a.cache.PutWithTTL(id, string(body), 10*time.Minute, time.Hour)
+		a.pages.Invalidate(r.URL.Path)
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (a *app) metrics(w http.ResponseWriter, r *http.Request) {
+	if err := a.flush(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		tenure.Stats
+		OriginLoads int
+	}{a.cache.Stats(), a.origin.Loads()})
+}
+
+func (a *app) flush() error {
+	a.logMu.Lock()
+	defer a.logMu.Unlock()
+
+	if a.logError != nil {
+		return a.logError
+	}
+
+	return a.buf.Flush()
+}
+
+// lockedWriter serializes writes to the app's buffered snapshot file against flushes
+type lockedWriter struct {
+	a *app
+}
+
+func (lw lockedWriter) Write(p []byte) (int, error) {
+	lw.a.logMu.Lock()
+	defer lw.a.logMu.Unlock()
+
+	return lw.a.buf.Write(p)
+}
+
+// origin is a slow backing store, e.g. a remote database
+type origin struct {
+	mu      sync.Mutex
+	latency time.Duration
+	data    map[interface{}]interface{}
+	loads   int
+}
+
+func newOrigin(latency time.Duration) *origin {
+	return &origin{latency: latency, data: make(map[interface{}]interface{})}
+}
+
+func (o *origin) Load(key interface{}) (interface{}, error) {
+	time.Sleep(o.latency)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.loads++
+	if v, ok := o.data[key]; ok {
+		return v, nil
+	}
+
+	return nil, tenure.ErrNotFound
+}
+
+func (o *origin) Write(key, value interface{}) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.data[key] = value
+	return nil
+}
+
+func (o *origin) Delete(key interface{}) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.data, key)
+	return nil
+}
+
+// Loads returns the number of loads served by the origin
+func (o *origin) Loads() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.loads
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func do(t *testing.T, h http.Handler, method, path, body string) (int, string, string) {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+
+	b, _ := ioutil.ReadAll(rec.Result().Body)
+	return rec.Code, strings.TrimSpace(string(b)), rec.Header().Get("X-Cache")
+}
+
+func TestApp(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	snapshot := filepath.Join(t.TempDir(), "app.ndjson")
+
+	o := newOrigin(0)
+	o.data["1"] = "one"
+
+	a, err := newApp(config{Capacity: 16, Snapshot: snapshot, Origin: o, Clock: clock})
+	if err != nil {
+		t.Fatalf("Failed to initialize the app; see %v", err)
+	}
+
+	if code, body, x := do(t, a, http.MethodGet, "/items/1", ""); code != http.StatusOK || body != `{"id":"1","value":"one"}` || x != "MISS" {
+		t.Fatalf("Read-through mismatch; Have (%v, %s, %s)", code, body, x)
+	}
+
+	if _, _, x := do(t, a, http.MethodGet, "/items/1", ""); x != "HIT" || o.Loads() != 1 {
+		t.Fatalf("Rendered pages should be served from the middleware; Have (%s, %v loads)", x, o.Loads())
+	}
+
+	if code, _, _ := do(t, a, http.MethodGet, "/items/2", ""); code != http.StatusNotFound {
+		t.Fatalf("Items absent from the origin should not be found; Have %v", code)
+	}
+
+	if code, _, _ := do(t, a, http.MethodPut, "/items/1", "uno"); code != http.StatusNoContent || o.data["1"] != "uno" {
+		t.Fatalf("Updates should be written through to the origin; Have (%v, %v)", code, o.data["1"])
+	}
+
+	if _, body, x := do(t, a, http.MethodGet, "/items/1", ""); x != "MISS" || !strings.Contains(body, "uno") || o.Loads() != 2 {
+		t.Fatalf("Updates should invalidate rendered pages, but not reach the origin; Have (%s, %s, %v loads)", x, body, o.Loads())
+	}
+
+	clock.Advance(2 * time.Hour)
+	o.data["1"] = "eins"
+
+	if _, body, _ := do(t, a, http.MethodGet, "/items/1", ""); !strings.Contains(body, "eins") || o.Loads() != 3 {
+		t.Fatalf("Expired items should be reloaded from the origin; Have (%s, %v loads)", body, o.Loads())
+	}
+
+	var m struct {
+		Hits        uint64
+		OriginLoads int
+	}
+	if _, body, _ := do(t, a, http.MethodGet, "/metrics", ""); json.Unmarshal([]byte(body), &m) != nil || m.Hits != 1 || m.OriginLoads != 3 {
+		t.Fatalf("Metrics mismatch; Have %+v", m)
+	}
+
+	if _, body, _ := do(t, a, http.MethodGet, "/admin/keys", ""); body != `["1"]` {
+		t.Fatalf("Admin API mismatch; Have %s", body)
+	}
+
+	if err := a.Close(); err != nil {
+		t.Fatalf("Failed to close the app; see %v", err)
+	}
+
+	// a restarted app restores its cache from the snapshot, sparing the origin
+	restarted, err := newApp(config{Capacity: 16, Snapshot: snapshot, Origin: newOrigin(0), Clock: clock})
+	if err != nil {
+		t.Fatalf("Failed to restart the app; see %v", err)
+	}
+	defer restarted.Close()
+
+	if _, body, _ := do(t, restarted, http.MethodGet, "/items/1", ""); !strings.Contains(body, "eins") || restarted.origin.Loads() != 0 {
+		t.Fatalf("Restarted apps should serve from the snapshot; Have (%s, %v loads)", body, restarted.origin.Loads())
+	}
+
+	b, _ := ioutil.ReadFile(snapshot)
+	if n := strings.Count(string(b), "\n"); n != 1 {
+		t.Fatalf("Snapshots should be compacted upon restart; Have %v events", n)
+	}
+}
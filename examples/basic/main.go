@@ -0,0 +1,42 @@
+// Command basic demonstrates the fundamentals of a tenure cache: insertion, lookup, eviction and resizing
+package main
+
+import (
+	"fmt"
+	"log"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+func main() {
+	lru, err := tenure.New(3, func(key, value interface{}) {
+		fmt.Printf("evicted %v=%v\n", key, value)
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer lru.Close()
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+
+	// reading "a" designates it as most recently-used, so "b" is evicted in its stead
+	if v, ok := lru.Get("a"); ok {
+		fmt.Printf("a=%v\n", v)
+	}
+
+	lru.Put("d", 4)
+
+	// Peek and Has leave recency untouched
+	fmt.Printf("has b: %v, peek c: %v\n", lru.Has("b"), lru.Peek("c"))
+
+	k, v := lru.LeastRecentlyUsed()
+	fmt.Printf("least recently-used: %v=%v\n", k, v)
+
+	n := lru.AdjustCapacity(1)
+	fmt.Printf("shrinking evicted %d; keys: %v\n", n, lru.Keys())
+
+	lru.Drop()
+	fmt.Printf("size after drop: %d\n", lru.Size())
+}
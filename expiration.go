@@ -0,0 +1,180 @@
+package tenure
+
+import "time"
+
+// ExpirationMode selects the instant from which an entry's TTLs are measured
+type ExpirationMode int
+
+const (
+	// ExpireDefault defers to the cache's default mode; see WithExpirationMode
+	ExpireDefault ExpirationMode = iota
+	// ExpireAbsolute measures TTLs from the entry's insertion, or its last update
+	ExpireAbsolute
+	// ExpireSliding measures the hard TTL from the entry's last access, such that an entry in use never expires
+	// The soft TTL bounds the age of the value rather than idleness, and so remains measured from insertion
+	ExpireSliding
+)
+
+// NoExpiry is the TTL reported for an entry bearing no hard expiry; see (*LRUCache).TTL
+const NoExpiry time.Duration = -1
+
+// WithExpirationMode configures the mode in which TTLs are measured for entries that do not select their own;
+// absent this option, TTLs are absolute
+// Under ExpireSliding, each access via Get, GetOrLoad, Lookup or Probe extends the entry's hard expiry by its
+// original TTL; Peek, Has and Inspect do not count as accesses
+func WithExpirationMode(m ExpirationMode) Option {
+	return func(lc *LRUCache) {
+		lc.expiration = m
+	}
+}
+
+// Expiration selects the mode in which the entry's TTLs are measured, overriding the cache's default; see
+// WithExpirationMode
+func Expiration(m ExpirationMode) EntryOption {
+	return func(spec *entrySpec) {
+		spec.mode = m
+	}
+}
+
+// Touch designates the entry for the given key as most recently-used and extends its expiry, where sliding,
+// without retrieving its value or recording a hit
+// Returns a boolean flag indicating whether the key was extant
+func (lc *LRUCache) Touch(key interface{}) bool {
+	key = lc.mapKey(key)
+
+	lc.acquire(key)
+	defer lc.lock.Unlock()
+
+	kv := lc.live(key)
+	if kv == nil {
+		return false
+	}
+
+	lc.promote(kv)
+	if kv.slide(lc.clock.Now()) {
+		lc.schedule(kv)
+	}
+
+	return true
+}
+
+// ExpireAt sets the hard expiry of the entry for the given key to `t`, clamping its soft expiry thereto
+// The entry's expiry thenceforth is absolute, whatever its expiration mode; a `t` not after the present expires
+// the entry immediately. Recency is unaffected
+// Returns a boolean flag indicating whether the key was extant
+func (lc *LRUCache) ExpireAt(key interface{}, t time.Time) bool {
+	key = lc.mapKey(key)
+
+	lc.acquire(key)
+	defer lc.lock.Unlock()
+
+	kv := lc.live(key)
+	if kv == nil {
+		return false
+	}
+
+	kv.hardExpiry, kv.sliding, kv.hardTTL = t, false, 0
+	if kv.softExpiry.IsZero() || kv.softExpiry.After(t) {
+		kv.softExpiry = t
+	}
+	lc.schedule(kv)
+
+	lc.live(key)
+	return true
+}
+
+// Persist removes the soft and hard expiries of the entry for the given key, such that it is only ever
+// removed by eviction or deletion. Recency is unaffected
+// Returns a boolean flag indicating whether the key was extant
+func (lc *LRUCache) Persist(key interface{}) bool {
+	key = lc.mapKey(key)
+
+	lc.acquire(key)
+	defer lc.lock.Unlock()
+
+	kv := lc.live(key)
+	if kv == nil {
+		return false
+	}
+
+	kv.softExpiry, kv.hardExpiry, kv.sliding, kv.hardTTL = time.Time{}, time.Time{}, false, 0
+	lc.unschedule(kv)
+
+	return true
+}
+
+// TTL returns the time remaining until the hard expiry of the entry for the given key, or NoExpiry if it bears
+// none, without enacting the eviction policy. The boolean flag is false if the key is not extant, or is past
+// its hard expiry
+func (lc *LRUCache) TTL(key interface{}) (remaining time.Duration, ok bool) {
+	key = lc.mapKey(key)
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	kv, ok := lc.cache[key]
+	if !ok {
+		return 0, false
+	}
+
+	now := lc.clock.Now()
+	switch {
+	case kv.expired(now):
+		return 0, false
+	case kv.hardExpiry.IsZero():
+		return NoExpiry, true
+	}
+
+	return kv.hardExpiry.Sub(now), true
+}
+
+// Age returns the time elapsed since the entry for the given key was inserted or last updated, without
+// enacting the eviction policy. The boolean flag is false if the key is not extant, or is past its hard expiry
+func (lc *LRUCache) Age(key interface{}) (age time.Duration, ok bool) {
+	key = lc.mapKey(key)
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	kv, ok := lc.cache[key]
+	if !ok {
+		return 0, false
+	}
+
+	now := lc.clock.Now()
+	if kv.expired(now) {
+		return 0, false
+	}
+
+	return now.Sub(kv.created), true
+}
+
+/* Utilities */
+
+// live returns the entry for an already-mapped key, or nil if not extant; an entry found past its hard expiry
+// is removed. The write lock must be held
+func (lc *LRUCache) live(key interface{}) *pair {
+	kv, ok := lc.cache[key]
+	if !ok {
+		return nil
+	}
+
+	if kv.expired(lc.clock.Now()) {
+		lc.purgeLRUItem(kv)
+		lc.observeLifetime(kv, true)
+		lc.emit(EventExpire, kv)
+		lc.release(kv)
+		return nil
+	}
+
+	return kv
+}
+
+// slide extends the hard expiry of a sliding entry upon its access at `now`, reporting whether it did so
+func (p *pair) slide(now time.Time) bool {
+	if p.sliding {
+		p.hardExpiry = now.Add(p.hardTTL)
+	}
+
+	return p.sliding
+}
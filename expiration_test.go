@@ -0,0 +1,205 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestSlidingExpiration(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(4, nil, WithClock(clock), WithExpirationMode(ExpireSliding))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithTTL("a", 1, 0, time.Minute)
+	lru.PutWith("b", 2, TTL(0, time.Minute), Expiration(ExpireAbsolute))
+	lru.PutWithTTL("c", 3, 0, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		clock.Advance(40 * time.Second)
+		lru.Get("a")
+		lru.Get("b")
+		lru.Peek("c")
+	}
+
+	if v, ok := lru.Get("a"); !ok || v != 1 {
+		t.Fatalf("Sliding entries should be extended upon access; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	if _, ok := lru.Get("b"); ok {
+		t.Fatal("Absolute entries should expire regardless of access")
+	}
+
+	if _, ok := lru.Get("c"); ok {
+		t.Fatal("Peek should not extend sliding entries")
+	}
+
+	clock.Advance(2 * time.Minute)
+
+	if _, ok := lru.Get("a"); ok {
+		t.Fatal("Sliding entries should expire once idle for their TTL")
+	}
+}
+
+func TestSlidingExpirationPerEntry(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(4, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWith("a", 1, TTL(30*time.Second, time.Minute), Expiration(ExpireSliding))
+	lru.PutWithTTL("b", 2, 0, time.Minute)
+
+	clock.Advance(40 * time.Second)
+
+	if _, stale, ok := lru.Lookup("a"); !ok || !stale {
+		t.Fatalf("Soft TTLs should be measured from insertion; Have (stale=%v, ok=%v), Want (stale=true, ok=true)", stale, ok)
+	}
+	lru.Lookup("b")
+
+	clock.Advance(40 * time.Second)
+
+	if _, stale, ok := lru.Lookup("a"); !ok || !stale {
+		t.Fatalf("Sliding entry mismatch; Have (stale=%v, ok=%v), Want (stale=true, ok=true)", stale, ok)
+	}
+
+	if _, _, ok := lru.Lookup("b"); ok {
+		t.Fatal("Entries should default to absolute expiration")
+	}
+
+	lru.Put("a", 3)
+	if info, _ := lru.Inspect("a"); !info.HardExpiry.IsZero() {
+		t.Fatal("Put should clear sliding TTLs")
+	}
+}
+
+func TestTouch(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(2, nil, WithClock(clock), WithExpirationMode(ExpireSliding))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithTTL("a", 1, 0, time.Minute)
+	lru.Put("b", 2)
+
+	clock.Advance(40 * time.Second)
+
+	if !lru.Touch("a") || lru.Touch("z") {
+		t.Fatal("Touch should report whether the key was extant")
+	}
+
+	lru.Put("c", 3)
+
+	if keys := lru.Keys(); len(keys) != 2 || keys[0] != "a" || keys[1] != "c" {
+		t.Fatalf("Touch should designate the entry as most recently-used; Have %v, Want [a c]", keys)
+	}
+
+	clock.Advance(40 * time.Second)
+
+	if !lru.Has("a") {
+		t.Fatal("Touch should extend sliding entries")
+	}
+
+	if s := lru.Stats(); s.Hits != 0 {
+		t.Fatalf("Touch should not record hits; Have %v, Want %v", s.Hits, 0)
+	}
+
+	clock.Advance(time.Minute)
+
+	if lru.Touch("a") || lru.Size() != 1 {
+		t.Fatal("Touch should remove expired entries")
+	}
+}
+
+func TestExpireAtAndPersist(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(4, nil, WithClock(clock), WithExpirationMode(ExpireSliding))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.PutWithTTL("b", 2, 0, time.Minute)
+	lru.PutWithTTL("c", 3, 0, time.Minute)
+
+	if !lru.ExpireAt("a", clock.Now().Add(time.Hour)) || lru.ExpireAt("z", clock.Now()) {
+		t.Fatal("ExpireAt should report whether the key was extant")
+	}
+
+	if info, _ := lru.Inspect("a"); !info.HardExpiry.Equal(clock.Now().Add(time.Hour)) || !info.SoftExpiry.Equal(info.HardExpiry) {
+		t.Fatalf("Expiry mismatch; Have (soft=%v, hard=%v)", info.SoftExpiry, info.HardExpiry)
+	}
+
+	if !lru.Persist("b") || lru.Persist("z") {
+		t.Fatal("Persist should report whether the key was extant")
+	}
+
+	lru.ExpireAt("c", clock.Now().Add(90*time.Second))
+
+	for i := 0; i < 3; i++ {
+		clock.Advance(40 * time.Second)
+		lru.Get("c")
+	}
+
+	if lru.Has("c") {
+		t.Fatal("ExpireAt should render the expiry absolute")
+	}
+
+	clock.Advance(24 * time.Hour)
+
+	if lru.Has("a") || !lru.Has("b") {
+		t.Fatalf("Expiry mismatch; Have (a=%v, b=%v), Want (a=false, b=true)", lru.Has("a"), lru.Has("b"))
+	}
+
+	if !lru.ExpireAt("b", clock.Now()) || lru.Peek("b") != nil {
+		t.Fatal("ExpireAt the present should expire the entry immediately")
+	}
+}
+
+func TestTTLAndAge(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(4, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.PutWithTTL("b", 2, 0, time.Minute)
+	clock.Advance(20 * time.Second)
+
+	if ttl, ok := lru.TTL("a"); !ok || ttl != NoExpiry {
+		t.Fatalf("TTL mismatch; Have (%v, %v), Want (%v, true)", ttl, ok, NoExpiry)
+	}
+
+	if ttl, ok := lru.TTL("b"); !ok || ttl != 40*time.Second {
+		t.Fatalf("TTL mismatch; Have (%v, %v), Want (%v, true)", ttl, ok, 40*time.Second)
+	}
+
+	if age, ok := lru.Age("a"); !ok || age != 20*time.Second {
+		t.Fatalf("Age mismatch; Have (%v, %v), Want (%v, true)", age, ok, 20*time.Second)
+	}
+
+	if _, ok := lru.TTL("z"); ok {
+		t.Fatal("Absent keys should not report a TTL")
+	}
+
+	if keys := lru.Keys(); keys[1] != "b" {
+		t.Fatalf("TTL and Age should not enact the eviction policy; Have %v, Want [a b]", keys)
+	}
+
+	clock.Advance(time.Minute)
+
+	if _, ok := lru.Age("b"); ok {
+		t.Fatal("Expired entries should not report an age")
+	}
+}
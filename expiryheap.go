@@ -0,0 +1,61 @@
+package tenure
+
+import "container/heap"
+
+// expiryHeap is a min-heap of the entries bearing a hard expiry, ordered by that expiry, such that the janitor
+// may find every expired entry in time proportional to their number rather than to the size of the cache
+// Each entry records its position in the heap, offset by one so that the zero value denotes an entry absent
+type expiryHeap []*pair
+
+func (h expiryHeap) Len() int { return len(h) }
+
+func (h expiryHeap) Less(i, j int) bool { return h[i].hardExpiry.Before(h[j].hardExpiry) }
+
+func (h expiryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].slot, h[j].slot = i+1, j+1
+}
+
+func (h *expiryHeap) Push(x interface{}) {
+	p := x.(*pair)
+	p.slot = len(*h) + 1
+	*h = append(*h, p)
+}
+
+func (h *expiryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	p := old[n-1]
+	old[n-1] = nil
+	p.slot = 0
+	*h = old[:n-1]
+	return p
+}
+
+// peek returns the entry bearing the earliest hard expiry, or nil if none bears one
+func (h expiryHeap) peek() *pair {
+	if len(h) == 0 {
+		return nil
+	}
+
+	return h[0]
+}
+
+// schedule reconciles the entry's position in the expiry heap with its hard expiry; the write lock must be held
+func (lc *LRUCache) schedule(p *pair) {
+	switch {
+	case p.hardExpiry.IsZero():
+		lc.unschedule(p)
+	case p.slot == 0:
+		heap.Push(&lc.expiries, p)
+	default:
+		heap.Fix(&lc.expiries, p.slot-1)
+	}
+}
+
+// unschedule removes the entry from the expiry heap, if present; the write lock must be held
+func (lc *LRUCache) unschedule(p *pair) {
+	if p.slot != 0 {
+		heap.Remove(&lc.expiries, p.slot-1)
+	}
+}
@@ -0,0 +1,98 @@
+package tenure
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+// checkExpiries verifies the expiry heap holds exactly the extant entries bearing a hard expiry, in heap order
+func checkExpiries(t *testing.T, lc *LRUCache) {
+	t.Helper()
+
+	want := 0
+	for _, kv := range lc.cache {
+		if kv.hardExpiry.IsZero() {
+			if kv.slot != 0 {
+				t.Fatalf("Entry %v bears no expiry, yet is scheduled", kv.key)
+			}
+			continue
+		}
+
+		want++
+		if kv.slot == 0 || lc.expiries[kv.slot-1] != kv {
+			t.Fatalf("Entry %v bears an expiry, yet is not scheduled", kv.key)
+		}
+	}
+
+	if len(lc.expiries) != want {
+		t.Fatalf("Expiry heap size mismatch; Have %v, Want %v", len(lc.expiries), want)
+	}
+
+	for i := 1; i < len(lc.expiries); i++ {
+		if lc.expiries.Less(i, (i-1)/2) {
+			t.Fatalf("Expiry heap order violated at %v", i)
+		}
+	}
+}
+
+func TestExpiryHeap(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(64, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		lru.PutWithTTL(i, i, 0, time.Duration(100-i)*time.Second)
+	}
+	checkExpiries(t, lru)
+
+	lru.Put(90, 0)
+	lru.PutWith(91, 0, TTL(0, time.Hour), Expiration(ExpireSliding))
+	lru.Del(92)
+	lru.Persist(93)
+	lru.ExpireAt(94, clock.Now().Add(time.Minute))
+	clock.Advance(time.Second)
+	lru.Get(91)
+	checkExpiries(t, lru)
+
+	lru.AdjustCapacity(16)
+	checkExpiries(t, lru)
+
+	lru.Compact()
+	checkExpiries(t, lru)
+
+	lru.Drop()
+	checkExpiries(t, lru)
+}
+
+func TestJanitorSweepsInOrderOfExpiry(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(1000, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		lru.PutWithTTL(fmt.Sprint(i), i, 0, time.Duration(i+1)*time.Second)
+	}
+
+	clock.Advance(10 * time.Second)
+
+	j := &janitor{cfg: JanitorConfig{Budget: time.Hour}, lc: lru}
+
+	found, exhausted := j.sweep()
+	if found != 10 || exhausted {
+		t.Fatalf("Sweep should remove every expired entry; Have (%v, %v), Want (%v, %v)", found, exhausted, 10, false)
+	}
+
+	if lru.Has("9") || !lru.Has("10") {
+		t.Fatal("Sweep should remove the earliest expiring entries")
+	}
+	checkExpiries(t, lru)
+}
@@ -0,0 +1,59 @@
+package tenure
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// mapSlotBytes approximates the footprint of each slot of the lookup map: an interface key, a pointer, its hash
+// byte and its share of bucket overhead, at the map's average load factor
+const mapSlotBytes = 32
+
+// EstimatedBytes reports the approximate heap footprint of the cache, i.e. its keys and values, the internal
+// records of its entries, and its lookup map and expiry heap, e.g. for dashboards and capacity planning
+// Values are measured by the cache's Sizer, if configured (see WithSizer), and are otherwise measured shallowly
+// as are keys: strings and byte slices by their lengths, and other values by the sizes of their types alone,
+// excluding anything they reference. The lookup map is measured at its peak size, as Go maps retain their peak
+// footprint until compacted. EstimatedBytes walks every entry, and so is costly for large caches
+func (lc *LRUCache) EstimatedBytes() int64 {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	slots := lc.peak
+	if n := len(lc.cache); n > slots {
+		slots = n
+	}
+
+	total := int64(slots)*mapSlotBytes + int64(cap(lc.expiries))*int64(unsafe.Sizeof(&pair{}))
+
+	for _, kv := range lc.cache {
+		total += int64(unsafe.Sizeof(*kv)) + shallowSize(kv.key) + int64(len(kv.provenance))
+
+		if lc.sizer != nil {
+			total += kv.weight
+		} else {
+			total += shallowSize(kv.value)
+		}
+	}
+
+	return total
+}
+
+/* Utilities */
+
+// shallowSize approximates the footprint of a value boxed in an interface, excluding anything it references
+// other than the contents of strings and byte slices
+func shallowSize(v interface{}) int64 {
+	switch t := v.(type) {
+	case nil:
+		return 0
+	case string:
+		return int64(len(t))
+	case []byte:
+		return int64(cap(t))
+	case *KeyRef:
+		return int64(unsafe.Sizeof(*t)) + shallowSize(t.Key)
+	}
+
+	return int64(reflect.TypeOf(v).Size())
+}
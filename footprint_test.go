@@ -0,0 +1,45 @@
+package tenure
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEstimatedBytes(t *testing.T) {
+	lru, err := New(16, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	empty := lru.EstimatedBytes()
+
+	lru.Put("key", strings.Repeat("x", 1000))
+	small := lru.EstimatedBytes()
+
+	if small-empty < 1000 {
+		t.Fatalf("Estimate should account for values; Have %v, Want at least %v", small-empty, 1000)
+	}
+
+	sized, err := New(16, nil, WithSizer(func(key, value interface{}) int64 { return 1 << 20 }))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	sized.Put("key", "value")
+	if n := sized.EstimatedBytes(); n < 1<<20 {
+		t.Fatalf("Estimate should measure values by the Sizer; Have %v, Want at least %v", n, 1<<20)
+	}
+
+	for i := 0; i < 16; i++ {
+		lru.Put(i, i)
+	}
+	full := lru.EstimatedBytes()
+
+	for i := 0; i < 16; i++ {
+		lru.Del(i)
+	}
+
+	if n := lru.EstimatedBytes(); n <= empty || n >= full {
+		t.Fatalf("Estimate should retain the map's peak footprint alone; Have %v, Want between %v and %v", n, empty, full)
+	}
+}
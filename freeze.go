@@ -0,0 +1,70 @@
+package tenure
+
+import "errors"
+
+// ErrReadOnly is returned by the write transactions of a FrozenView
+var ErrReadOnly = errors.New("cache view is read-only")
+
+// FrozenView is a read-only view over an LRUCache; see (*LRUCache).Freeze
+type FrozenView struct {
+	lc *LRUCache
+}
+
+// Freeze returns a read-only view over the cache, e.g. to serve from during a controlled drain or migration,
+// or to expose the cache to untrusted plugins. Reads via the view neither enact the eviction policy, nor record
+// hits or misses, nor read through to a backing Store; writes are refused with ErrReadOnly
+// The view reflects the cache as it is mutated via other means; it is not a snapshot
+func (lc *LRUCache) Freeze() *FrozenView {
+	return &FrozenView{lc: lc}
+}
+
+// Get returns the value for the given key and true if extant; else, returns nil, false
+func (fv *FrozenView) Get(key interface{}) (value interface{}, ok bool) {
+	key = fv.lc.mapKey(key)
+
+	fv.lc.lock.RLock()
+	defer fv.lc.lock.RUnlock()
+
+	if kv, ok := fv.lc.cache[key]; ok && !kv.expired(fv.lc.clock.Now()) && !kv.negative {
+		if v, err := fv.lc.decode(kv.value); err == nil {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// Peek returns the value for the given key, or nil if not extant
+func (fv *FrozenView) Peek(key interface{}) (value interface{}) {
+	return fv.lc.Peek(key)
+}
+
+// Has returns a boolean flag verifying the existence (or lack thereof) of a given key
+func (fv *FrozenView) Has(key interface{}) (ok bool) {
+	return fv.lc.Has(key)
+}
+
+// Keys returns a slice of the keys currently extant in the cache
+func (fv *FrozenView) Keys() []interface{} {
+	return fv.lc.Keys()
+}
+
+// Size returns the current size of the cache
+func (fv *FrozenView) Size() int {
+	return fv.lc.Size()
+}
+
+// Put refuses to insert the given key / value pair, returning ErrReadOnly
+func (fv *FrozenView) Put(key, value interface{}) error {
+	return ErrReadOnly
+}
+
+// Del refuses to delete the given key, returning ErrReadOnly
+func (fv *FrozenView) Del(key interface{}) error {
+	return ErrReadOnly
+}
+
+// Drop refuses to drop the cache's items, returning ErrReadOnly
+func (fv *FrozenView) Drop() error {
+	return ErrReadOnly
+}
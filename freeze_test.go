@@ -0,0 +1,40 @@
+package tenure
+
+import "testing"
+
+func TestFreeze(t *testing.T) {
+	lru, err := New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+
+	fv := lru.Freeze()
+	if v, ok := fv.Get("a"); !ok || v != 1 {
+		t.Fatalf("Frozen view value mismatch; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	if _, ok := fv.Get("c"); ok {
+		t.Error("Frozen view should miss on keys not extant")
+	}
+
+	// Reads via the view must not designate a as most recently-used
+	lru.Put("c", 3)
+	if lru.Has("a") || !lru.Has("b") {
+		t.Fatalf("Reads via a frozen view should not mutate recency; Have %v", lru.Keys())
+	}
+
+	if s := lru.Stats(); s.Hits != 0 || s.Misses != 0 {
+		t.Errorf("Reads via a frozen view should not be recorded; Have %v hits, %v misses", s.Hits, s.Misses)
+	}
+
+	if fv.Put("d", 4) != ErrReadOnly || fv.Del("b") != ErrReadOnly || fv.Drop() != ErrReadOnly {
+		t.Fatal("Writes via a frozen view should be refused with ErrReadOnly")
+	}
+
+	if fv.Size() != 2 || !fv.Has("b") || fv.Peek("c") != 3 {
+		t.Errorf("Frozen view should reflect the cache; Have %v", fv.Keys())
+	}
+}
@@ -0,0 +1,108 @@
+package tenure
+
+import "container/list"
+
+// ghostList is a bounded FIFO of recently evicted keys, retained without their values
+// A miss on a key present in the ghost list would have been a hit with a larger capacity
+type ghostList struct {
+	capacity int
+	links    *list.List
+	index    map[interface{}]*list.Element
+}
+
+func newGhostList(capacity int) *ghostList {
+	return &ghostList{
+		capacity: capacity,
+		links:    list.New(),
+		index:    make(map[interface{}]*list.Element, capacity),
+	}
+}
+
+func (g *ghostList) add(key interface{}) {
+	if g == nil || g.capacity <= 0 {
+		return
+	}
+
+	if e, ok := g.index[key]; ok {
+		g.links.MoveToFront(e)
+		return
+	}
+
+	g.index[key] = g.links.PushFront(key)
+
+	for g.links.Len() > g.capacity {
+		e := g.links.Back()
+		g.links.Remove(e)
+		delete(g.index, e.Value)
+	}
+}
+
+func (g *ghostList) has(key interface{}) bool {
+	if g == nil {
+		return false
+	}
+
+	_, ok := g.index[key]
+	return ok
+}
+
+func (g *ghostList) remove(key interface{}) {
+	if g == nil {
+		return
+	}
+
+	if e, ok := g.index[key]; ok {
+		g.links.Remove(e)
+		delete(g.index, key)
+	}
+}
+
+func (g *ghostList) resize(capacity int) {
+	g.capacity = capacity
+
+	for g.links.Len() > g.capacity {
+		e := g.links.Back()
+		g.links.Remove(e)
+		delete(g.index, e.Value)
+	}
+}
+
+// WithGhostTracking configures the cache to retain up to `capacity` of its most recently evicted keys, without
+// their values, so as to quantify undersizing: misses on retained keys, which would have hit with a larger
+// capacity, are counted by the GhostHits stat; see also WasRecentlyEvicted
+// Keys are retained in the form in which they are stored, e.g. hashed where so configured
+func WithGhostTracking(capacity int) Option {
+	return func(lc *LRUCache) {
+		if capacity <= 0 {
+			return
+		}
+
+		lc.ghosts = newGhostList(capacity)
+	}
+}
+
+// WasRecentlyEvicted reports whether the given key is among the most recently evicted keys retained per
+// WithGhostTracking; it is always false absent that option. Keys are forgotten upon reinsertion
+func (lc *LRUCache) WasRecentlyEvicted(key interface{}) bool {
+	key = lc.mapKey(key)
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	return lc.ghosts.has(lc.keys.token(key))
+}
+
+/* Utilities */
+
+// trackGhosts retains up to `capacity` recently evicted keys, so as to count misses that would have hit
+func (lc *LRUCache) trackGhosts(capacity int) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	if lc.ghosts == nil {
+		lc.ghosts = newGhostList(capacity)
+		return
+	}
+
+	lc.ghosts.resize(capacity)
+}
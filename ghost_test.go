@@ -0,0 +1,51 @@
+package tenure
+
+import "testing"
+
+func TestGhostTracking(t *testing.T) {
+	lru, err := New(2, nil, WithGhostTracking(2))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		lru.Put(k, k)
+	}
+
+	// a, b and c were evicted, though only the two most recent evictions are retained
+	if lru.WasRecentlyEvicted("a") || !lru.WasRecentlyEvicted("b") || !lru.WasRecentlyEvicted("c") {
+		t.Fatal("The most recently evicted keys should be retained, up to the ghost capacity")
+	}
+
+	if lru.WasRecentlyEvicted("e") {
+		t.Fatal("Extant keys should not be reported as evicted")
+	}
+
+	lru.Get("a")
+	lru.Get("b")
+	lru.Get("c")
+
+	if s := lru.Stats(); s.GhostHits != 2 || s.Misses != 3 {
+		t.Fatalf("Misses on retained keys should count as ghost hits; Have %v of %v misses, Want %v of %v", s.GhostHits, s.Misses, 2, 3)
+	}
+
+	lru.Put("b", "b")
+	if lru.WasRecentlyEvicted("b") {
+		t.Fatal("Reinserted keys should be forgotten")
+	}
+}
+
+func TestGhostTrackingDisabled(t *testing.T) {
+	lru, err := New(1, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Get("a")
+
+	if lru.WasRecentlyEvicted("a") || lru.Stats().GhostHits != 0 {
+		t.Fatal("Evicted keys should not be retained absent ghost tracking")
+	}
+}
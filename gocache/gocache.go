@@ -0,0 +1,143 @@
+// Package gocache adapts a tenure cache to the API of patrickmn/go-cache, i.e. string keys, per-item expiration
+// and a default expiration, such that tenure plugs into code written against that library
+package gocache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+const (
+	// NoExpiration designates an item that never expires
+	NoExpiration time.Duration = -1
+	// DefaultExpiration designates an item that expires per the cache's default expiration
+	DefaultExpiration time.Duration = 0
+)
+
+// Cache exposes a tenure cache via the API of patrickmn/go-cache
+// Unlike go-cache, the cache is bounded by its capacity: items may be evicted ahead of their expiration
+// Add and Replace are atomic with respect to the adapter's other writes, though not to writes made on the
+// underlying cache directly
+type Cache struct {
+	lru               *tenure.LRUCache
+	defaultExpiration time.Duration
+	lock              sync.Mutex
+}
+
+// New initializes a new cache of the given capacity, whose items expire after `defaultExpiration` unless
+// set otherwise; NoExpiration, or any duration of zero or less, designates items that never expire
+// Any number of trailing options configure the underlying tenure cache
+func New(capacity int, defaultExpiration time.Duration, opts ...tenure.Option) (*Cache, error) {
+	lru, err := tenure.New(capacity, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Wrap(lru, defaultExpiration), nil
+}
+
+// Wrap adapts an extant tenure cache, whose items expire after `defaultExpiration` unless set otherwise
+func Wrap(lru *tenure.LRUCache, defaultExpiration time.Duration) *Cache {
+	return &Cache{lru: lru, defaultExpiration: defaultExpiration}
+}
+
+// Unwrap returns the underlying tenure cache
+func (c *Cache) Unwrap() *tenure.LRUCache {
+	return c.lru
+}
+
+// Set adds an item to the cache, replacing any extant item, expiring after `d`; see DefaultExpiration and
+// NoExpiration
+func (c *Cache) Set(k string, x interface{}, d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.set(k, x, d)
+}
+
+// SetDefault adds an item to the cache, replacing any extant item, expiring per the default expiration
+func (c *Cache) SetDefault(k string, x interface{}) {
+	c.Set(k, x, DefaultExpiration)
+}
+
+// Add adds an item to the cache only if no unexpired item is extant for the key, else returning an error
+func (c *Cache) Add(k string, x interface{}, d time.Duration) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.lru.Has(k) {
+		return fmt.Errorf("item %s already exists", k)
+	}
+
+	c.set(k, x, d)
+	return nil
+}
+
+// Replace replaces the item for the key only if an unexpired item is extant, else returning an error
+func (c *Cache) Replace(k string, x interface{}, d time.Duration) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.lru.Has(k) {
+		return fmt.Errorf("item %s doesn't exist", k)
+	}
+
+	c.set(k, x, d)
+	return nil
+}
+
+// Get returns the item for the key and true if extant and unexpired; else, returns nil, false
+func (c *Cache) Get(k string) (interface{}, bool) {
+	return c.lru.Get(k)
+}
+
+// GetWithExpiration returns the item for the key, as does Get, along with its expiration time, which is zero
+// where the item never expires
+func (c *Cache) GetWithExpiration(k string) (interface{}, time.Time, bool) {
+	info, ok := c.lru.Inspect(k)
+	if !ok || info.Negative {
+		return nil, time.Time{}, false
+	}
+
+	v, ok := c.lru.Get(k)
+	return v, info.HardExpiry, ok
+}
+
+// Delete deletes the item for the key, if extant
+func (c *Cache) Delete(k string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.lru.Del(k)
+}
+
+// Flush deletes every item from the cache
+func (c *Cache) Flush() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.lru.Drop()
+}
+
+// ItemCount returns the number of items in the cache, which may include expired items not yet removed
+func (c *Cache) ItemCount() int {
+	return c.lru.Size()
+}
+
+/* Utilities */
+
+func (c *Cache) set(k string, x interface{}, d time.Duration) {
+	if d == DefaultExpiration {
+		d = c.defaultExpiration
+	}
+
+	if d <= 0 {
+		c.lru.Put(k, x)
+		return
+	}
+
+	c.lru.PutWithTTL(k, x, 0, d)
+}
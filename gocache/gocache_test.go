@@ -0,0 +1,64 @@
+package gocache
+
+import (
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestCache(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	c, err := New(10, time.Minute, tenure.WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new cache; see %v", err)
+	}
+
+	c.SetDefault("a", 1)
+	c.Set("b", 2, NoExpiration)
+	c.Set("c", 3, time.Hour)
+
+	if _, exp, ok := c.GetWithExpiration("a"); !ok || !exp.Equal(clock.Now().Add(time.Minute)) {
+		t.Errorf("Default expiration mismatch; Have %v, Want %v", exp, clock.Now().Add(time.Minute))
+	}
+
+	if _, exp, ok := c.GetWithExpiration("b"); !ok || !exp.IsZero() {
+		t.Errorf("Items set with NoExpiration should never expire; Have %v", exp)
+	}
+
+	clock.Advance(2 * time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Error("Items should expire per the default expiration")
+	}
+
+	if v, ok := c.Get("c"); !ok || v != 3 {
+		t.Errorf("Value mismatch; Have (%v, %v), Want (3, true)", v, ok)
+	}
+
+	if c.Add("c", 4, DefaultExpiration) == nil {
+		t.Error("Add should refuse extant items")
+	}
+
+	if c.Replace("d", 4, DefaultExpiration) == nil {
+		t.Error("Replace should refuse items not extant")
+	}
+
+	if c.Add("d", 4, DefaultExpiration) != nil || c.Replace("c", 5, DefaultExpiration) != nil {
+		t.Fatal("Add and Replace should succeed where their conditions hold")
+	}
+
+	if v, _ := c.Get("c"); v != 5 {
+		t.Errorf("Replaced value mismatch; Have %v, Want %v", v, 5)
+	}
+
+	c.Delete("b")
+	if _, ok := c.Get("b"); ok {
+		t.Error("Deleted items should be absent")
+	}
+
+	c.Flush()
+	if c.ItemCount() != 0 {
+		t.Errorf("Flush should delete every item; Have %v", c.ItemCount())
+	}
+}
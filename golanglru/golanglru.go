@@ -0,0 +1,156 @@
+// Package golanglru adapts a tenure cache to the method set of hashicorp/golang-lru's Cache, such that projects
+// may swap libraries without touching call sites
+package golanglru
+
+import (
+	"sync"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// Cache exposes a tenure cache via the method set of hashicorp/golang-lru's Cache
+// Compound transactions, e.g. ContainsOrAdd and RemoveOldest, are atomic with respect to the adapter's other
+// transactions, though not to transactions made on the underlying cache directly
+type Cache struct {
+	lru  *tenure.LRUCache
+	lock sync.Mutex
+}
+
+// New initializes a new cache of the given size
+func New(size int) (*Cache, error) {
+	return NewWithEvict(size, nil)
+}
+
+// NewWithEvict initializes a new cache of the given size, invoking `onEvicted` upon each eviction
+// Any number of trailing options configure the underlying tenure cache
+func NewWithEvict(size int, onEvicted func(key, value interface{}), opts ...tenure.Option) (*Cache, error) {
+	lru, err := tenure.New(size, onEvicted, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Wrap(lru), nil
+}
+
+// Wrap adapts an extant tenure cache
+func Wrap(lru *tenure.LRUCache) *Cache {
+	return &Cache{lru: lru}
+}
+
+// Unwrap returns the underlying tenure cache
+func (c *Cache) Unwrap() *tenure.LRUCache {
+	return c.lru
+}
+
+// Add adds a value to the cache, returning true if an eviction occurred
+func (c *Cache) Add(key, value interface{}) (evicted bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.lru.Put(key, value)
+}
+
+// Get looks up a key's value, designating it as most recently-used
+func (c *Cache) Get(key interface{}) (value interface{}, ok bool) {
+	return c.lru.Get(key)
+}
+
+// Contains reports whether a key is in the cache, without updating its recency
+func (c *Cache) Contains(key interface{}) bool {
+	return c.lru.Has(key)
+}
+
+// Peek returns a key's value without updating its recency
+func (c *Cache) Peek(key interface{}) (value interface{}, ok bool) {
+	value = c.lru.Peek(key)
+	return value, value != nil || c.lru.Has(key)
+}
+
+// ContainsOrAdd reports whether a key is in the cache without updating its recency, adding the value if not
+func (c *Cache) ContainsOrAdd(key, value interface{}) (ok, evicted bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.lru.Has(key) {
+		return true, false
+	}
+
+	return false, c.lru.Put(key, value)
+}
+
+// PeekOrAdd returns a key's value without updating its recency if present, adding the value if not
+func (c *Cache) PeekOrAdd(key, value interface{}) (previous interface{}, ok, evicted bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.lru.Has(key) {
+		return c.lru.Peek(key), true, false
+	}
+
+	return nil, false, c.lru.Put(key, value)
+}
+
+// Remove removes a key from the cache, returning true if it was present
+func (c *Cache) Remove(key interface{}) (present bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.lru.Del(key)
+}
+
+// Resize changes the cache's size, returning the number of entries evicted
+func (c *Cache) Resize(size int) (evicted int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.lru.AdjustCapacity(size)
+}
+
+// RemoveOldest removes the least recently-used entry, returning it
+func (c *Cache) RemoveOldest() (key, value interface{}, ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if key, value, ok = c.oldest(); ok {
+		c.lru.Del(key)
+	}
+
+	return
+}
+
+// GetOldest returns the least recently-used entry without updating its recency
+func (c *Cache) GetOldest() (key, value interface{}, ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.oldest()
+}
+
+// Keys returns the keys in the cache, from least to most recently-used
+func (c *Cache) Keys() []interface{} {
+	return c.lru.Keys()
+}
+
+// Len returns the number of entries in the cache
+func (c *Cache) Len() int {
+	return c.lru.Size()
+}
+
+// Purge removes every entry from the cache
+func (c *Cache) Purge() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.lru.Drop()
+}
+
+/* Utilities */
+
+func (c *Cache) oldest() (key, value interface{}, ok bool) {
+	if c.lru.Size() == 0 {
+		return nil, nil, false
+	}
+
+	key, value = c.lru.LeastRecentlyUsed()
+	return key, value, true
+}
@@ -0,0 +1,69 @@
+package golanglru
+
+import "testing"
+
+func TestCache(t *testing.T) {
+	var evicted []interface{}
+	c, err := NewWithEvict(2, func(key, value interface{}) { evicted = append(evicted, key) })
+	if err != nil {
+		t.Fatalf("Failed to initialize a new cache; see %v", err)
+	}
+
+	c.Add("a", 1)
+	c.Add("b", 2)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("Value mismatch; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	if !c.Add("c", 3) || len(evicted) != 1 || evicted[0] != "b" {
+		t.Fatalf("Adding beyond the size should evict the least recently-used key; Have %v", evicted)
+	}
+
+	if ok, _ := c.ContainsOrAdd("a", 10); !ok {
+		t.Error("ContainsOrAdd should report extant keys")
+	}
+
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Errorf("ContainsOrAdd should not replace extant values; Have %v", v)
+	}
+
+	if k, v, ok := c.GetOldest(); !ok || k != "a" || v != 1 {
+		t.Errorf("Oldest entry mismatch; Have (%v, %v, %v), Want (a, 1, true)", k, v, ok)
+	}
+
+	if k, _, ok := c.RemoveOldest(); !ok || k != "a" || c.Contains("a") || c.Len() != 1 {
+		t.Errorf("RemoveOldest should remove the least recently-used entry; Have %v", c.Keys())
+	}
+
+	if !c.Remove("c") || c.Remove("c") {
+		t.Error("Remove should report whether the key was present")
+	}
+
+	if _, _, ok := c.RemoveOldest(); ok {
+		t.Error("RemoveOldest should report an empty cache")
+	}
+}
+
+func TestResizeAndPurge(t *testing.T) {
+	c, err := New(4)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new cache; see %v", err)
+	}
+
+	for i := 0; i < 4; i++ {
+		c.Add(i, i)
+	}
+
+	if n := c.Resize(2); n != 2 {
+		t.Errorf("Resize eviction count mismatch; Have %v, Want %v", n, 2)
+	}
+
+	if keys := c.Keys(); len(keys) != 2 || keys[0] != 2 || keys[1] != 3 {
+		t.Errorf("Keys should be ordered from least to most recently-used; Have %v", keys)
+	}
+
+	c.Purge()
+	if c.Len() != 0 {
+		t.Errorf("Purge should remove every entry; Have %v", c.Len())
+	}
+}
@@ -0,0 +1,83 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// UnaryInvoker invokes a unary RPC, populating `reply`; it mirrors grpc.UnaryInvoker less its connection and options
+type UnaryInvoker func(ctx context.Context, method string, req, reply interface{}) error
+
+// MemoizeConfig configures a Memoizer
+type MemoizeConfig struct {
+	// Methods maps the full names of idempotent methods, e.g. "/pkg.Service/Method", to the lifetime of their
+	// memoized replies; calls to other methods are never memoized
+	Methods map[string]time.Duration
+	// MaxReplySize bounds the size of memoizable replies, once marshaled; zero imposes no bound
+	MaxReplySize int
+	// Marshal serializes requests and replies; it defaults to encoding/json, and protobuf messages
+	// should be serialized with proto.Marshal
+	Marshal func(v interface{}) ([]byte, error)
+	// Unmarshal deserializes memoized replies into the caller's reply; it defaults to encoding/json, and
+	// protobuf messages should be deserialized with proto.Unmarshal
+	Unmarshal func(data []byte, v interface{}) error
+}
+
+// Memoizer memoizes the replies of idempotent unary calls in an LRUCache, keyed by method and serialized request
+// Memoizer is not itself a grpc.UnaryClientInterceptor, as this package does not depend on gRPC: Invoke takes
+// the reduced UnaryInvoker in place of the connection, invoker and call options. The tenuregrpc module provides
+// the interceptor, by way of tenuregrpc.UnaryClientInterceptor, alongside protobuf serialization
+type Memoizer struct {
+	c   *tenure.LRUCache
+	cfg MemoizeConfig
+}
+
+// NewMemoizer initializes a new Memoizer over `c`, which should be dedicated to it
+func NewMemoizer(c *tenure.LRUCache, cfg MemoizeConfig) *Memoizer {
+	if cfg.Marshal == nil {
+		cfg.Marshal = json.Marshal
+	}
+
+	if cfg.Unmarshal == nil {
+		cfg.Unmarshal = json.Unmarshal
+	}
+
+	return &Memoizer{c: c, cfg: cfg}
+}
+
+// Invoke serves the call from a memoized reply where extant, else invokes it, memoizing its reply on success
+// Failures to serialize the request or to deserialize a memoized reply fall back to invoking the call
+func (m *Memoizer) Invoke(ctx context.Context, method string, req, reply interface{}, invoker UnaryInvoker) error {
+	ttl, ok := m.cfg.Methods[method]
+	if !ok || ttl <= 0 {
+		return invoker(ctx, method, req, reply)
+	}
+
+	b, err := m.cfg.Marshal(req)
+	if err != nil {
+		return invoker(ctx, method, req, reply)
+	}
+
+	key := method + "\x00" + string(b)
+
+	if v, ok := m.c.Get(key); ok {
+		if err := m.cfg.Unmarshal(v.([]byte), reply); err == nil {
+			return nil
+		}
+
+		m.c.Del(key)
+	}
+
+	if err := invoker(ctx, method, req, reply); err != nil {
+		return err
+	}
+
+	if b, err := m.cfg.Marshal(reply); err == nil && (m.cfg.MaxReplySize <= 0 || len(b) <= m.cfg.MaxReplySize) {
+		m.c.PutWithTTL(key, b, 0, ttl)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+type echo struct {
+	Text string
+}
+
+func TestMemoizer(t *testing.T) {
+	lru, err := tenure.New(8, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	m := NewMemoizer(lru, MemoizeConfig{
+		Methods:      map[string]time.Duration{"/echo.Echo/Get": time.Minute},
+		MaxReplySize: 32,
+	})
+
+	var calls int
+	fail := false
+	invoker := func(ctx context.Context, method string, req, reply interface{}) error {
+		calls++
+		if fail {
+			return errors.New("unavailable")
+		}
+		reply.(*echo).Text = strings.Repeat(req.(*echo).Text, 2)
+		return nil
+	}
+
+	call := func(method, text string) (string, error) {
+		var reply echo
+		err := m.Invoke(context.Background(), method, &echo{Text: text}, &reply, invoker)
+		return reply.Text, err
+	}
+
+	if r, _ := call("/echo.Echo/Get", "a"); r != "aa" || calls != 1 {
+		t.Fatalf("Miss mismatch; Have (%q, %v calls)", r, calls)
+	}
+
+	if r, _ := call("/echo.Echo/Get", "a"); r != "aa" || calls != 1 {
+		t.Fatalf("Memoized replies should be served; Have (%q, %v calls)", r, calls)
+	}
+
+	if call("/echo.Echo/Get", "b"); calls != 2 {
+		t.Fatal("Distinct requests should be memoized distinctly")
+	}
+
+	call("/echo.Echo/Set", "a")
+	call("/echo.Echo/Set", "a")
+	if calls != 4 {
+		t.Fatal("Unlisted methods should never be memoized")
+	}
+
+	long := strings.Repeat("x", 20)
+	call("/echo.Echo/Get", long)
+	call("/echo.Echo/Get", long)
+	if calls != 6 {
+		t.Fatal("Replies exceeding the size bound should not be memoized")
+	}
+
+	fail = true
+	if _, err := call("/echo.Echo/Get", "c"); err == nil || lru.Size() != 2 {
+		t.Fatal("Failed calls should not be memoized")
+	}
+}
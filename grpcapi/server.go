@@ -0,0 +1,224 @@
+// Package grpcapi implements the Cache service described by tenure.proto over a tenure LRUCache, so that
+// processes written in other languages may consume a tenure-backed cache. Keys are addressed as strings
+// and values as bytes, so the service is best suited to caches keyed by strings.
+//
+// The package is not itself a registrable gRPC service, and depends on neither gRPC nor protobuf. Its message
+// types and the CacheServer and Cache_WatchServer interfaces are hand-written mirrors of the shape emitted by
+// protoc-gen-go and protoc-gen-go-grpc for tenure.proto, not generated code, and so cannot be passed to a
+// grpc.Server as is. To serve over gRPC, use the tenuregrpc module, a module apart which carries the gRPC and
+// protobuf dependencies and the bindings generated from tenure.proto, and whose Register adapts a Server thereto.
+//
+// The package also provides a Memoizer, which caches the replies of idempotent unary calls on the client side;
+// likewise free of a gRPC dependency, it is installed as a grpc.UnaryClientInterceptor by way of tenuregrpc
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// watchBuffer is the number of events buffered per watcher before the watcher is disconnected
+const watchBuffer = 256
+
+// CacheServer is the server API for the Cache service
+type CacheServer interface {
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	Del(context.Context, *DelRequest) (*DelResponse, error)
+	Keys(context.Context, *KeysRequest) (*KeysResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	Watch(*WatchRequest, Cache_WatchServer) error
+}
+
+// Cache_WatchServer is the server side of a Watch stream
+type Cache_WatchServer interface {
+	Send(*Event) error
+	Context() context.Context
+}
+
+type GetRequest struct{ Key string }
+
+type GetResponse struct {
+	Value []byte
+	Found bool
+}
+
+type PutRequest struct {
+	Key   string
+	Value []byte
+}
+
+type PutResponse struct{ Evicted bool }
+
+type DelRequest struct{ Key string }
+
+type DelResponse struct{ Deleted bool }
+
+type KeysRequest struct{}
+
+type KeysResponse struct{ Keys []string }
+
+type StatsRequest struct{}
+
+type StatsResponse struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Capacity  int64
+	Size      int64
+}
+
+type WatchRequest struct{}
+
+type Event struct {
+	Seq          uint64
+	Op           string
+	Key          string
+	Value        []byte
+	TimeUnixNano int64
+}
+
+var _ CacheServer = (*Server)(nil)
+
+// ErrWatchOverflow is returned from Watch when a watcher falls too far behind the cache's mutations
+var ErrWatchOverflow = fmt.Errorf("grpcapi: watcher fell behind by more than %d events", watchBuffer)
+
+// Server implements CacheServer over an LRUCache
+type Server struct {
+	c *tenure.LRUCache
+
+	lock     sync.Mutex
+	watchers map[chan *Event]struct{}
+}
+
+// NewServer initializes a new Server over `c`
+// To serve Watch streams, construct `c` with the Server's Notify method as a change sink, e.g. by way of
+// tenure.WithChangeChannel and Forward
+func NewServer(c *tenure.LRUCache) *Server {
+	return &Server{c: c, watchers: make(map[chan *Event]struct{})}
+}
+
+// Get retrieves the value for a key, designating it as most recently-used
+func (s *Server) Get(_ context.Context, req *GetRequest) (*GetResponse, error) {
+	v, ok := s.c.Get(req.Key)
+	if !ok {
+		return &GetResponse{}, nil
+	}
+
+	return &GetResponse{Value: toBytes(v), Found: true}, nil
+}
+
+// Put stores a value for a key
+func (s *Server) Put(_ context.Context, req *PutRequest) (*PutResponse, error) {
+	return &PutResponse{Evicted: s.c.Put(req.Key, req.Value)}, nil
+}
+
+// Del deletes a key
+func (s *Server) Del(_ context.Context, req *DelRequest) (*DelResponse, error) {
+	return &DelResponse{Deleted: s.c.Del(req.Key)}, nil
+}
+
+// Keys lists every key; keys are passed through the cache's Redactor, if any
+func (s *Server) Keys(_ context.Context, _ *KeysRequest) (*KeysResponse, error) {
+	keys := s.c.Keys()
+
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		rk, _ := s.c.Redact(k, nil)
+		out[i] = fmt.Sprint(rk)
+	}
+
+	return &KeysResponse{Keys: out}, nil
+}
+
+// Stats retrieves usage statistics
+func (s *Server) Stats(_ context.Context, _ *StatsRequest) (*StatsResponse, error) {
+	st := s.c.Stats()
+
+	return &StatsResponse{
+		Hits:      st.Hits,
+		Misses:    st.Misses,
+		Evictions: st.Evictions,
+		Capacity:  int64(st.Capacity),
+		Size:      int64(st.ListLen),
+	}, nil
+}
+
+// Watch streams every mutation notified to the Server until the stream's context is done
+// A watcher that falls behind by more than a fixed number of events is disconnected with ErrWatchOverflow
+func (s *Server) Watch(_ *WatchRequest, stream Cache_WatchServer) error {
+	ch := make(chan *Event, watchBuffer)
+
+	s.lock.Lock()
+	s.watchers[ch] = struct{}{}
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		delete(s.watchers, ch)
+		s.lock.Unlock()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case e, ok := <-ch:
+			if !ok {
+				return ErrWatchOverflow
+			}
+
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Notify fans the given cache mutation out to every watcher
+func (s *Server) Notify(e tenure.Event) {
+	ev := &Event{
+		Seq:          e.Seq,
+		Op:           e.Op.String(),
+		Key:          fmt.Sprint(e.Key),
+		TimeUnixNano: e.Time.UnixNano(),
+	}
+	if e.Value != nil {
+		ev.Value = toBytes(e.Value)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for ch := range s.watchers {
+		select {
+		case ch <- ev:
+		default:
+			close(ch)
+			delete(s.watchers, ch)
+		}
+	}
+}
+
+// Forward notifies every event received on `ch` until it is closed
+func (s *Server) Forward(ch <-chan tenure.Event) {
+	for e := range ch {
+		s.Notify(e)
+	}
+}
+
+/* Utilities */
+
+func toBytes(v interface{}) []byte {
+	switch v := v.(type) {
+	case []byte:
+		return v
+	case string:
+		return []byte(v)
+	default:
+		return []byte(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,131 @@
+package grpcapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+type watchStream struct {
+	ctx    context.Context
+	events chan *Event
+}
+
+func (w *watchStream) Send(e *Event) error {
+	w.events <- e
+	return nil
+}
+
+func (w *watchStream) Context() context.Context {
+	return w.ctx
+}
+
+func newServer(t *testing.T) *Server {
+	ch := make(chan tenure.Event, 16)
+
+	lru, err := tenure.New(2, nil, tenure.WithChangeChannel(ch))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	s := NewServer(lru)
+	go s.Forward(ch)
+
+	return s
+}
+
+func TestServerDataAPI(t *testing.T) {
+	s := newServer(t)
+	ctx := context.Background()
+
+	s.Put(ctx, &PutRequest{Key: "a", Value: []byte("1")})
+	s.Put(ctx, &PutRequest{Key: "b", Value: []byte("2")})
+
+	if res, _ := s.Get(ctx, &GetRequest{Key: "a"}); !res.Found || string(res.Value) != "1" {
+		t.Fatalf("Get mismatch; Have %+v", res)
+	}
+
+	if res, _ := s.Put(ctx, &PutRequest{Key: "c", Value: []byte("3")}); !res.Evicted {
+		t.Fatal("Expected an eviction")
+	}
+
+	if res, _ := s.Keys(ctx, &KeysRequest{}); len(res.Keys) != 2 || res.Keys[0] != "a" || res.Keys[1] != "c" {
+		t.Fatalf("Keys mismatch; Have %v", res.Keys)
+	}
+
+	if res, _ := s.Del(ctx, &DelRequest{Key: "a"}); !res.Deleted {
+		t.Fatal("Expected a deletion")
+	}
+
+	if res, _ := s.Get(ctx, &GetRequest{Key: "a"}); res.Found {
+		t.Fatal("Expected a miss")
+	}
+
+	if res, _ := s.Stats(ctx, &StatsRequest{}); res.Hits != 1 || res.Misses != 1 || res.Evictions != 1 || res.Size != 1 {
+		t.Fatalf("Stats mismatch; Have %+v", res)
+	}
+}
+
+func TestServerWatch(t *testing.T) {
+	s := newServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchStream{ctx: ctx, events: make(chan *Event, 16)}
+
+	done := make(chan error)
+	go func() { done <- s.Watch(&WatchRequest{}, stream) }()
+
+	for {
+		s.lock.Lock()
+		n := len(s.watchers)
+		s.lock.Unlock()
+		if n == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	s.Put(ctx, &PutRequest{Key: "a", Value: []byte("1")})
+
+	select {
+	case e := <-stream.events:
+		if e.Op != "put" || e.Key != "a" || string(e.Value) != "1" {
+			t.Fatalf("Event mismatch; Have %+v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out awaiting an event")
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Watch should end with the stream's context; Have %v", err)
+	}
+}
+
+func TestServerWatchOverflow(t *testing.T) {
+	s := NewServer(nil)
+
+	s.lock.Lock()
+	ch := make(chan *Event, watchBuffer)
+	s.watchers[ch] = struct{}{}
+	s.lock.Unlock()
+
+	for i := 0; i <= watchBuffer; i++ {
+		s.Notify(tenure.Event{Op: tenure.EventPut, Key: i})
+	}
+
+	if _, ok := s.watchers[ch]; ok {
+		t.Fatal("An overflowing watcher should be disconnected")
+	}
+
+	n := 0
+	for range ch {
+		n++
+	}
+
+	if n != watchBuffer {
+		t.Fatalf("Buffered events mismatch; Have %v, Want %v", n, watchBuffer)
+	}
+}
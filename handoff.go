@@ -0,0 +1,150 @@
+package tenure
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+)
+
+// handoffVersion identifies the encoding of handed-off state
+const handoffVersion = 1
+
+type handoffHeader struct {
+	Version int
+	Count   int
+}
+
+type handoffEntry struct {
+	Key        interface{}
+	Value      interface{}
+	Created    time.Time
+	SoftExpiry time.Time
+	HardExpiry time.Time
+	Provenance []byte
+	Negative   bool
+}
+
+func init() {
+	gob.Register(HashedKey{})
+}
+
+// Handoff writes the cache's entries, along with their TTLs and provenance, to `w` for a successor process to
+// Receive, so that a hot restart need not begin with a cold cache. Entries are written from least to most
+// recently-used, so the receiver reproduces their recency. Keys and values are encoded per encoding/gob;
+// as such, keys and values of types other than Go's basic types must be registered via gob.Register
+// by both donor and receiver. The cache remains usable throughout; mutations made after Handoff has
+// begun are not handed off
+func (lc *LRUCache) Handoff(w io.Writer) error {
+	lc.lock.RLock()
+	now := lc.clock.Now()
+	entries := make([]handoffEntry, 0, lc.links.Len())
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		if kv.expired(now) {
+			continue
+		}
+
+		h := handoffEntry{Key: kv.key, Value: lc.decoded(kv.value), Created: kv.created, SoftExpiry: kv.softExpiry, HardExpiry: kv.hardExpiry, Provenance: kv.provenance, Negative: kv.negative}
+		// gob cannot encode the NilValue sentinel; the receiver applies its own nil policy to nil values instead
+		if h.Value == NilValue {
+			h.Value = nil
+		}
+
+		entries = append(entries, h)
+	}
+	lc.lock.RUnlock()
+
+	enc := gob.NewEncoder(w)
+	if err := enc.Encode(handoffHeader{Version: handoffVersion, Count: len(entries)}); err != nil {
+		return err
+	}
+
+	for i := range entries {
+		if err := enc.Encode(&entries[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Receive restores entries written by a predecessor's Handoff, enacting the eviction policy where they exceed
+// the cache's capacity. Entries that expired in transit are discarded. Received entries are neither
+// written to a backing Store nor published to an Invalidator; keys must be mapped identically by donor and
+// receiver, i.e. both must share any key hashing secret
+// Returns the number of entries restored
+func (lc *LRUCache) Receive(r io.Reader) (received int, err error) {
+	dec := gob.NewDecoder(r)
+
+	var h handoffHeader
+	if err := dec.Decode(&h); err != nil {
+		return 0, err
+	}
+
+	if h.Version != handoffVersion {
+		return 0, fmt.Errorf("unsupported handoff version %d", h.Version)
+	}
+
+	for i := 0; i < h.Count; i++ {
+		var e handoffEntry
+		if err := dec.Decode(&e); err != nil {
+			return received, err
+		}
+
+		if !e.HardExpiry.IsZero() && !lc.clock.Now().Before(e.HardExpiry) {
+			continue
+		}
+
+		spec := entrySpec{
+			provenance: e.Provenance,
+			negative:   e.Negative,
+			restore:    &timestamps{created: e.Created, softExpiry: e.SoftExpiry, hardExpiry: e.HardExpiry},
+		}
+
+		value := e.Value
+		if !e.Negative {
+			var err error
+			if value, err = lc.admit(value); err != nil {
+				continue
+			}
+		}
+
+		lc.insert(e.Key, value, spec)
+		received++
+	}
+
+	return received, nil
+}
+
+// ServeHandoff listens on the unix socket at `path` and hands off the cache's entries to the first process
+// to connect, e.g. a successor invoking ReceiveHandoff, before removing the socket
+func (lc *LRUCache) ServeHandoff(path string) error {
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	defer l.Close()
+
+	conn, err := l.Accept()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return lc.Handoff(conn)
+}
+
+// ReceiveHandoff connects to the unix socket at `path`, whereupon a predecessor is serving a handoff via
+// ServeHandoff, and receives its entries; see Receive
+func (lc *LRUCache) ReceiveHandoff(path string) (received int, err error) {
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	return lc.Receive(conn)
+}
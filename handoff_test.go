@@ -0,0 +1,92 @@
+package tenure
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestHandoff(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	donor, err := New(4, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	donor.Put("a", 1)
+	donor.PutWith("b", []byte("two"), TTL(time.Minute, time.Hour), Provenance([]byte("db")))
+	donor.PutWithTTL("c", "three", 0, time.Second)
+	donor.Put("d", 4.5)
+	donor.Get("a")
+
+	var buf bytes.Buffer
+	if err := donor.Handoff(&buf); err != nil {
+		t.Fatalf("Handoff failure; see %v", err)
+	}
+
+	clock.Advance(2 * time.Minute)
+
+	receiver, err := New(2, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	if n, err := receiver.Receive(&buf); err != nil || n != 3 {
+		t.Fatalf("Receive mismatch; Have (%v, %v), Want (3, nil)", n, err)
+	}
+
+	if keys := receiver.Keys(); len(keys) != 2 || keys[0] != "d" || keys[1] != "a" {
+		t.Fatalf("Recency should be reproduced; Have %v", keys)
+	}
+
+	if v, _ := receiver.Get("d"); v != 4.5 {
+		t.Fatalf("Value types should be preserved; Have %#v", v)
+	}
+
+	bigger, _ := New(4, nil, WithClock(clock))
+	buf.Reset()
+	donor.Handoff(&buf)
+	bigger.Receive(&buf)
+
+	info, ok := bigger.Inspect("b")
+	if !ok || string(info.Provenance) != "db" || !info.HardExpiry.Equal(time.Unix(0, 0).Add(time.Hour)) {
+		t.Fatalf("TTLs and provenance should be preserved; Have %+v", info)
+	}
+
+	if _, stale, _ := bigger.Lookup("b"); !stale {
+		t.Fatal("Stale entries should remain stale")
+	}
+}
+
+func TestHandoffOverUnixSocket(t *testing.T) {
+	donor, _ := New(4, nil, WithNilPolicy(NilSentinel))
+	donor.Put("a", nil)
+	donor.Put("b", "two")
+
+	path := filepath.Join(t.TempDir(), "handoff.sock")
+
+	served := make(chan error)
+	go func() { served <- donor.ServeHandoff(path) }()
+
+	receiver, _ := New(4, nil, WithNilPolicy(NilSentinel))
+
+	var n int
+	var err error
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if n, err = receiver.ReceiveHandoff(path); err == nil {
+			break
+		}
+	}
+
+	if err != nil || n != 2 || <-served != nil {
+		t.Fatalf("Handoff over a unix socket failed; Have (%v, %v)", n, err)
+	}
+
+	if receiver.Peek("a") != NilValue || receiver.Peek("b") != "two" {
+		t.Fatal("Received entries mismatch")
+	}
+}
@@ -0,0 +1,186 @@
+package tenure
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/MatthewZito/tenure-go/internal/consistent"
+)
+
+// HashRingCache routes transactions across a set of LRUController nodes by consistent hashing of keys,
+// with `replicas` virtual nodes per node, enabling simple horizontal partitioning
+// Keys are hashed by their default format, so keys which format identically are routed identically
+type HashRingCache struct {
+	lock  sync.RWMutex
+	ring  *consistent.Ring
+	nodes map[string]LRUController
+}
+
+var _ LRUController = (*HashRingCache)(nil)
+
+// NewHashRing initializes a new HashRingCache over the given nodes, keyed by node name
+func NewHashRing(replicas int, nodes map[string]LRUController) (*HashRingCache, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("a hash ring must be initialized with at least one node")
+	}
+
+	hr := &HashRingCache{
+		ring:  consistent.New(replicas, nil),
+		nodes: make(map[string]LRUController, len(nodes)),
+	}
+
+	for name, n := range nodes {
+		hr.nodes[name] = n
+		hr.ring.Add(name)
+	}
+
+	return hr, nil
+}
+
+// AddNode adds a node to the ring; keys remapped to it are not migrated
+func (hr *HashRingCache) AddNode(name string, node LRUController) {
+	hr.lock.Lock()
+	defer hr.lock.Unlock()
+
+	hr.nodes[name] = node
+	hr.ring.Add(name)
+}
+
+// RemoveNode removes a node from the ring; its keys are not migrated
+func (hr *HashRingCache) RemoveNode(name string) {
+	hr.lock.Lock()
+	defer hr.lock.Unlock()
+
+	delete(hr.nodes, name)
+	hr.ring.Remove(name)
+}
+
+// Node returns the name of the node owning the given key
+func (hr *HashRingCache) Node(key interface{}) string {
+	hr.lock.RLock()
+	defer hr.lock.RUnlock()
+
+	return hr.ring.Get(ringKey(key))
+}
+
+// Get retrieves the value for the given key from its owning node
+func (hr *HashRingCache) Get(key interface{}) (value interface{}, ok bool) {
+	return hr.owner(key).Get(key)
+}
+
+// Put stores the given key / value pair on its owning node
+func (hr *HashRingCache) Put(key, value interface{}) (wasEvicted bool) {
+	return hr.owner(key).Put(key, value)
+}
+
+// Del deletes the given key from its owning node
+func (hr *HashRingCache) Del(key interface{}) (wasDeleted bool) {
+	return hr.owner(key).Del(key)
+}
+
+// Keys returns the keys of every node
+func (hr *HashRingCache) Keys() []interface{} {
+	keys := []interface{}{}
+	for _, n := range hr.snapshot() {
+		keys = append(keys, n.Keys()...)
+	}
+
+	return keys
+}
+
+// Peek returns the value for the given key from its owning node, without enacting the eviction policy
+func (hr *HashRingCache) Peek(key interface{}) (value interface{}) {
+	return hr.owner(key).Peek(key)
+}
+
+// Has reports whether the given key exists on its owning node
+func (hr *HashRingCache) Has(key interface{}) (ok bool) {
+	return hr.owner(key).Has(key)
+}
+
+// Drop drops all items from every node
+func (hr *HashRingCache) Drop() {
+	for _, n := range hr.snapshot() {
+		n.Purge()
+	}
+}
+
+// Purge drops all items, as does Drop
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (hr *HashRingCache) Purge() {
+	hr.Drop()
+}
+
+// Size returns the total size of every node
+func (hr *HashRingCache) Size() (n int) {
+	for _, node := range hr.snapshot() {
+		n += node.Size()
+	}
+
+	return
+}
+
+// AdjustCapacity divides `bufCap` evenly among the nodes, returning the total number of items evicted
+// Any remainder is spread one apiece across the first nodes, and every node retains a capacity of at least one,
+// such that the nodes' total capacity exceeds `bufCap` where it is less than the number of nodes
+// A ring of no nodes has no capacity to adjust
+func (hr *HashRingCache) AdjustCapacity(bufCap int) (numEvicted int) {
+	nodes := hr.snapshot()
+	if len(nodes) == 0 {
+		return 0
+	}
+
+	share, rem := bufCap/len(nodes), bufCap%len(nodes)
+	for i, n := range nodes {
+		c := share
+		if i < rem {
+			c++
+		}
+		if c < 1 {
+			c = 1
+		}
+
+		numEvicted += n.AdjustCapacity(c)
+	}
+
+	return
+}
+
+/* Utilities */
+
+func (hr *HashRingCache) owner(key interface{}) LRUController {
+	hr.lock.RLock()
+	defer hr.lock.RUnlock()
+
+	if n, ok := hr.nodes[hr.ring.Get(ringKey(key))]; ok {
+		return n
+	}
+
+	return NullCache{}
+}
+
+func (hr *HashRingCache) snapshot() []LRUController {
+	hr.lock.RLock()
+	defer hr.lock.RUnlock()
+
+	nodes := make([]LRUController, 0, len(hr.nodes))
+	for _, n := range hr.nodes {
+		nodes = append(nodes, n)
+	}
+
+	return nodes
+}
+
+func ringKey(key interface{}) string {
+	switch k := key.(type) {
+	case string:
+		return k
+	case int:
+		return strconv.Itoa(k)
+	default:
+		return fmt.Sprint(k)
+	}
+}
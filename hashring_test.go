@@ -0,0 +1,93 @@
+package tenure
+
+import (
+	"testing"
+)
+
+func TestHashRingRouting(t *testing.T) {
+	nodes := make(map[string]LRUController)
+	for _, name := range []string{"a", "b", "c"} {
+		lru, err := New(64, nil)
+		if err != nil {
+			t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+		}
+		nodes[name] = lru
+	}
+
+	hr, err := NewHashRing(32, nodes)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new hash ring; see %v", err)
+	}
+
+	for i := 0; i < 90; i++ {
+		hr.Put(i, i)
+	}
+
+	if hr.Size() != 90 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", hr.Size(), 90)
+	}
+
+	for name, n := range nodes {
+		if n.Size() == 0 {
+			t.Fatalf("Expected keys to be distributed to node %v", name)
+		}
+	}
+
+	for i := 0; i < 90; i++ {
+		if !nodes[hr.Node(i)].Has(i) {
+			t.Fatalf("Key %v not stored on its owning node %v", i, hr.Node(i))
+		}
+
+		if v, ok := hr.Get(i); !ok || v != i {
+			t.Fatalf("Routed read failure; Have (%v, %v), Want (%v, true)", v, ok, i)
+		}
+	}
+
+	hr.RemoveNode("a")
+	hr.Drop()
+
+	if nodes["a"].Size() == 0 {
+		t.Fatal("Removed nodes should no longer be addressed")
+	}
+}
+
+func TestHashRingAdjustCapacity(t *testing.T) {
+	caches := make(map[string]*LRUCache)
+	nodes := make(map[string]LRUController)
+	for _, name := range []string{"a", "b", "c"} {
+		lru, _ := New(64, nil)
+		caches[name], nodes[name] = lru, lru
+	}
+
+	hr, err := NewHashRing(32, nodes)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new hash ring; see %v", err)
+	}
+
+	total := func() (n int) {
+		for _, lru := range caches {
+			n += lru.Capacity()
+		}
+		return
+	}
+
+	hr.AdjustCapacity(10)
+	if n := total(); n != 10 {
+		t.Errorf("The remainder should be spread across nodes; Have total capacity %v, Want %v", n, 10)
+	}
+
+	hr.AdjustCapacity(1)
+	for name, lru := range caches {
+		if lru.Capacity() < 1 {
+			t.Errorf("Every node should retain a capacity of at least one; Have %v for node %v", lru.Capacity(), name)
+		}
+	}
+
+	for name := range nodes {
+		hr.RemoveNode(name)
+	}
+
+	if n := hr.AdjustCapacity(10); n != 0 {
+		t.Errorf("An empty ring should evict nothing; Have %v", n)
+	}
+}
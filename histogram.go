@@ -0,0 +1,69 @@
+package tenure
+
+import (
+	"math"
+	"time"
+)
+
+// Histogram is a cumulative distribution of durations over fixed, ascending bucket bounds
+// Counts[i] is the number of observations not exceeding Bounds[i] (and exceeding any lesser bound);
+// the final element of Counts tallies observations exceeding every bound
+type Histogram struct {
+	Bounds []time.Duration
+	Counts []uint64
+	Count  uint64
+	Sum    time.Duration
+}
+
+func newHistogram(bounds ...time.Duration) Histogram {
+	return Histogram{Bounds: bounds, Counts: make([]uint64, len(bounds)+1)}
+}
+
+// Observe records a single observation of `d`
+func (h *Histogram) Observe(d time.Duration) {
+	i := 0
+	for i < len(h.Bounds) && d > h.Bounds[i] {
+		i++
+	}
+
+	h.Counts[i]++
+	h.Count++
+	h.Sum += d
+}
+
+// Mean returns the arithmetic mean of all observations, or zero if there are none
+func (h Histogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+
+	return h.Sum / time.Duration(h.Count)
+}
+
+// Quantile returns the upper bound of the bucket containing the `q`-th quantile of observations
+// Observations exceeding every bound are reported as the greatest bound
+func (h Histogram) Quantile(q float64) time.Duration {
+	if h.Count == 0 || len(h.Bounds) == 0 {
+		return 0
+	}
+
+	rank := uint64(math.Ceil(q * float64(h.Count)))
+	if rank == 0 {
+		rank = 1
+	}
+
+	var seen uint64
+	for i, c := range h.Counts[:len(h.Bounds)] {
+		if seen += c; seen >= rank {
+			return h.Bounds[i]
+		}
+	}
+
+	return h.Bounds[len(h.Bounds)-1]
+}
+
+func (h Histogram) clone() Histogram {
+	c := h
+	c.Counts = append([]uint64(nil), h.Counts...)
+	return c
+}
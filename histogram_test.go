@@ -0,0 +1,27 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHistogram(t *testing.T) {
+	h := newHistogram(time.Millisecond, time.Second)
+
+	h.Observe(time.Microsecond)
+	h.Observe(time.Millisecond)
+	h.Observe(500 * time.Millisecond)
+	h.Observe(time.Minute)
+
+	if h.Counts[0] != 2 || h.Counts[1] != 1 || h.Counts[2] != 1 {
+		t.Fatalf("Bucket mismatch; Have %v, Want [2 1 1]", h.Counts)
+	}
+
+	if q := h.Quantile(0.75); q != time.Second {
+		t.Fatalf("Quantile mismatch; Have %v, Want %v", q, time.Second)
+	}
+
+	if q := h.Quantile(0.5); q != time.Millisecond {
+		t.Fatalf("Quantile mismatch; Have %v, Want %v", q, time.Millisecond)
+	}
+}
@@ -0,0 +1,185 @@
+package tenure
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"sort"
+	"sync"
+)
+
+// sketchDepth is the number of rows of a count-min sketch, i.e. of independent counters per key
+const sketchDepth = 4
+
+// KeyCount is a key and its approximate access count; see TopKeys
+type KeyCount struct {
+	Key   interface{}
+	Count uint64
+}
+
+// WithHotKeys configures the cache to track the approximate access frequency of keys, retaining the `n` most
+// frequently accessed as candidates for TopKeys. Frequencies are estimated by a count-min sketch, and so may
+// overestimate, never underestimate; they are halved periodically, such that the hottest keys are those hot
+// of late. Every lookup, hit or miss, counts as an access
+func WithHotKeys(n int) Option {
+	return func(lc *LRUCache) {
+		if n > 0 {
+			lc.hot = &hotKeys{n: n, seed: maphash.MakeSeed(), top: make(map[interface{}]uint64, n)}
+		}
+	}
+}
+
+// TopKeys returns up to `n` of the most frequently accessed keys with their approximate access counts, hottest
+// first, e.g. to find the skew behind lock contention. Keys are surfaced in stored form, as by Keys, save that
+// byte slice keys are surfaced as strings; keys matched by custom key functions are not tracked (see WithKeyFuncs)
+// Returns nil unless the cache is configured WithHotKeys, and at most the number of keys tracked thereby
+func (lc *LRUCache) TopKeys(n int) []KeyCount {
+	if lc.hot == nil {
+		return nil
+	}
+
+	return lc.hot.topKeys(n)
+}
+
+// hotKeys tracks the access frequency of keys by a count-min sketch, retaining the most frequent as candidates
+type hotKeys struct {
+	n    int
+	seed maphash.Seed
+	lock sync.Mutex
+	// rows are the sketch's counters; its width is sized to its first access, and is a power of two
+	rows [sketchDepth][]uint32
+	// accesses counts the accesses since the sketch was last halved
+	accesses int
+	top      map[interface{}]uint64
+}
+
+/* Utilities */
+
+// record counts an access to an already-mapped key
+func (hk *hotKeys) record(lc *LRUCache, key interface{}) {
+	if lc.keys.hashFn != nil {
+		return
+	}
+
+	token := lc.keys.token(key)
+	h := hk.hash(token)
+
+	hk.lock.Lock()
+	defer hk.lock.Unlock()
+
+	if hk.rows[0] == nil {
+		hk.size(lc.capacity)
+	}
+
+	est := hk.increment(h)
+	if hk.accesses++; hk.accesses >= 10*len(hk.rows[0]) {
+		hk.halve()
+	}
+
+	if _, ok := hk.top[token]; ok || len(hk.top) < hk.n {
+		hk.top[token] = est
+		return
+	}
+
+	// The coldest candidate is displaced by a hotter key
+	var (
+		coldest interface{}
+		min     uint64
+	)
+	for k, c := range hk.top {
+		if coldest == nil || c < min {
+			coldest, min = k, c
+		}
+	}
+
+	if est > min {
+		delete(hk.top, coldest)
+		hk.top[token] = est
+	}
+}
+
+func (hk *hotKeys) topKeys(n int) []KeyCount {
+	hk.lock.Lock()
+	defer hk.lock.Unlock()
+
+	keys := make([]KeyCount, 0, len(hk.top))
+	for k, c := range hk.top {
+		keys = append(keys, KeyCount{k, c})
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Count > keys[j].Count
+	})
+
+	if n < len(keys) {
+		keys = keys[:n]
+	}
+
+	return keys
+}
+
+// size allocates the sketch's counters, sized to the cache's capacity
+func (hk *hotKeys) size(capacity int) {
+	width := 1024
+	for width < capacity {
+		width <<= 1
+	}
+
+	for i := range hk.rows {
+		hk.rows[i] = make([]uint32, width)
+	}
+}
+
+// increment increments the key's counters, returning its estimated count, i.e. the least of its counters
+func (hk *hotKeys) increment(h uint64) uint64 {
+	mask := uint64(len(hk.rows[0]) - 1)
+	est := ^uint32(0)
+
+	for i := range hk.rows {
+		// Each row indexes by a distinct 16-bit rotation of the hash
+		c := &hk.rows[i][(h>>(16*uint(i))|h<<(64-16*uint(i)))&mask]
+		if *c < ^uint32(0) {
+			*c++
+		}
+		if *c < est {
+			est = *c
+		}
+	}
+
+	return uint64(est)
+}
+
+// halve halves every counter, and every candidate's count, aging the frequencies recorded
+func (hk *hotKeys) halve() {
+	for i := range hk.rows {
+		for j := range hk.rows[i] {
+			hk.rows[i][j] >>= 1
+		}
+	}
+
+	for k, c := range hk.top {
+		hk.top[k] = c >> 1
+	}
+
+	hk.accesses = 0
+}
+
+func (hk *hotKeys) hash(key interface{}) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hk.seed)
+
+	switch k := key.(type) {
+	case string:
+		h.WriteString(k)
+	case int:
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+		h.Write(b[:])
+	case HashedKey:
+		h.Write(k[:])
+	default:
+		fmt.Fprint(&h, k)
+	}
+
+	return h.Sum64()
+}
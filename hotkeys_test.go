@@ -0,0 +1,38 @@
+package tenure
+
+import "testing"
+
+func TestTopKeys(t *testing.T) {
+	lru, err := New(64, nil, WithHotKeys(3))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		lru.Get("hot")
+		if i%2 == 0 {
+			lru.Get("warm")
+		}
+		if i%4 == 0 {
+			lru.Get(7)
+		}
+		lru.Get(i)
+	}
+
+	top := lru.TopKeys(2)
+	if len(top) != 2 || top[0].Key != "hot" || top[1].Key != "warm" {
+		t.Fatalf("TopKeys mismatch; Have %v", top)
+	}
+
+	if top[0].Count < top[1].Count {
+		t.Fatal("TopKeys should be ordered hottest first")
+	}
+
+	if n := len(lru.TopKeys(10)); n != 3 {
+		t.Fatalf("TopKeys should report at most the number of keys tracked; Have %v, Want %v", n, 3)
+	}
+
+	if plain, _ := New(8, nil); plain.TopKeys(1) != nil {
+		t.Fatal("TopKeys should report nothing absent tracking")
+	}
+}
@@ -0,0 +1,297 @@
+// Package httpapi exposes an admin and data API over any tenure LRUController, so that operators may inspect
+// and manipulate a live cache with curl. Keys are addressed as strings, so the API is best suited to caches
+// keyed by strings. The following endpoints are served:
+//
+//	GET    /keys           list every key
+//	DELETE /keys           drop every entry
+//	GET    /keys/{key}     retrieve the value for a key, without enacting the eviction policy
+//	PUT    /keys/{key}     store the request body as the value for a key
+//	DELETE /keys/{key}     delete a key
+//	GET    /entries/{key}  retrieve an entry's metadata, where the controller supports inspection
+//	GET    /stats          retrieve usage statistics
+//	GET    /capacity       retrieve the capacity, where the controller reports it
+//	PUT    /capacity       adjust the capacity to the integer request body
+//	GET    /slowlog        retrieve the slow log, newest first, where the controller keeps one
+//	DELETE /slowlog        reset the slow log
+//
+// Keys and values emitted by the listing and metadata endpoints are passed through the controller's
+// Redactor, where the controller supports redaction
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// maxBodySize bounds the size of request bodies
+const maxBodySize = 1 << 20
+
+type redactor interface {
+	Redact(key, value interface{}) (rk, rv interface{})
+}
+
+type inspector interface {
+	Inspect(key interface{}) (tenure.EntryInfo, bool)
+}
+
+type statser interface {
+	Stats() tenure.Stats
+}
+
+type capacitor interface {
+	Capacity() int
+}
+
+type slowLogger interface {
+	SlowLog() []tenure.SlowEntry
+	ResetSlowLog()
+}
+
+// Handler serves the API over an LRUController
+type Handler struct {
+	c   tenure.LRUController
+	mux *http.ServeMux
+}
+
+// New initializes a new Handler over `c`
+func New(c tenure.LRUController) *Handler {
+	h := &Handler{c: c, mux: http.NewServeMux()}
+
+	h.mux.HandleFunc("/keys", h.keys)
+	h.mux.HandleFunc("/keys/", h.key)
+	h.mux.HandleFunc("/entries/", h.entry)
+	h.mux.HandleFunc("/stats", h.stats)
+	h.mux.HandleFunc("/capacity", h.capacity)
+	h.mux.HandleFunc("/slowlog", h.slowlog)
+
+	return h
+}
+
+// ServeHTTP dispatches the request to the appropriate endpoint
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) keys(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		keys := h.c.Keys()
+
+		out := make([]string, len(keys))
+		for i, k := range keys {
+			rk, _ := h.redact(k, nil)
+			out[i] = fmt.Sprint(rk)
+		}
+
+		writeJSON(w, http.StatusOK, out)
+	case http.MethodDelete:
+		h.c.Purge()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notAllowed(w, http.MethodGet, http.MethodDelete)
+	}
+}
+
+func (h *Handler) key(w http.ResponseWriter, r *http.Request) {
+	key, ok := pathKey(w, r, "/keys/")
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if !h.c.Has(key) {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		switch v := h.c.Peek(key).(type) {
+		case []byte:
+			w.Write(v)
+		default:
+			fmt.Fprint(w, v)
+		}
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		evicted := h.c.Put(key, string(body))
+		writeJSON(w, http.StatusOK, map[string]bool{"evicted": evicted})
+	case http.MethodDelete:
+		if !h.c.Del(key) {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notAllowed(w, http.MethodGet, http.MethodPut, http.MethodDelete)
+	}
+}
+
+type entryInfo struct {
+	Key        string    `json:"key"`
+	Value      string    `json:"value"`
+	Created    time.Time `json:"created"`
+	SoftExpiry time.Time `json:"softExpiry"`
+	HardExpiry time.Time `json:"hardExpiry"`
+	Hits       uint64    `json:"hits"`
+	Provenance string    `json:"provenance,omitempty"`
+}
+
+func (h *Handler) entry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		notAllowed(w, http.MethodGet)
+		return
+	}
+
+	key, ok := pathKey(w, r, "/entries/")
+	if !ok {
+		return
+	}
+
+	in, ok := h.c.(inspector)
+	if !ok {
+		http.Error(w, "the cache does not support inspection", http.StatusNotImplemented)
+		return
+	}
+
+	info, ok := in.Inspect(key)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	rk, rv := h.redact(info.Key, info.Value)
+	writeJSON(w, http.StatusOK, entryInfo{
+		Key:        fmt.Sprint(rk),
+		Value:      fmt.Sprint(rv),
+		Created:    info.Created,
+		SoftExpiry: info.SoftExpiry,
+		HardExpiry: info.HardExpiry,
+		Hits:       info.Hits,
+		Provenance: string(info.Provenance),
+	})
+}
+
+func (h *Handler) stats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		notAllowed(w, http.MethodGet)
+		return
+	}
+
+	if s, ok := h.c.(statser); ok {
+		writeJSON(w, http.StatusOK, s.Stats())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]int{"Size": h.c.Size()})
+}
+
+func (h *Handler) capacity(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		c, ok := h.c.(capacitor)
+		if !ok {
+			http.Error(w, "the cache does not report its capacity", http.StatusNotImplemented)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]int{"capacity": c.Capacity()})
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(string(body)))
+		if err != nil || n <= 0 {
+			http.Error(w, "capacity must be a whole number greater than zero", http.StatusBadRequest)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]int{"evicted": h.c.AdjustCapacity(n)})
+	default:
+		notAllowed(w, http.MethodGet, http.MethodPut)
+	}
+}
+
+type slowEntry struct {
+	ID       uint64        `json:"id"`
+	Op       tenure.SlowOp `json:"op"`
+	Key      string        `json:"key,omitempty"`
+	Time     time.Time     `json:"time"`
+	Duration time.Duration `json:"duration"`
+}
+
+func (h *Handler) slowlog(w http.ResponseWriter, r *http.Request) {
+	sl, ok := h.c.(slowLogger)
+	if !ok {
+		http.Error(w, "the cache does not keep a slow log", http.StatusNotImplemented)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		entries := sl.SlowLog()
+
+		out := make([]slowEntry, len(entries))
+		for i, e := range entries {
+			out[i] = slowEntry{ID: e.ID, Op: e.Op, Time: e.Time, Duration: e.Duration}
+			if e.Key != nil {
+				out[i].Key = fmt.Sprint(e.Key)
+			}
+		}
+
+		writeJSON(w, http.StatusOK, out)
+	case http.MethodDelete:
+		sl.ResetSlowLog()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notAllowed(w, http.MethodGet, http.MethodDelete)
+	}
+}
+
+/* Utilities */
+
+func (h *Handler) redact(key, value interface{}) (interface{}, interface{}) {
+	if r, ok := h.c.(redactor); ok {
+		return r.Redact(key, value)
+	}
+
+	return key, value
+}
+
+func pathKey(w http.ResponseWriter, r *http.Request, prefix string) (string, bool) {
+	key, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), prefix))
+	if err != nil || key == "" {
+		http.Error(w, "a key is required", http.StatusBadRequest)
+		return "", false
+	}
+
+	return key, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func notAllowed(w http.ResponseWriter, methods ...string) {
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+}
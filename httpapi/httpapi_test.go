@@ -0,0 +1,125 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+func do(t *testing.T, srv *httptest.Server, method, path, body string) (int, string) {
+	req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to build request; see %v", err)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failure; see %v", err)
+	}
+	defer res.Body.Close()
+
+	b, _ := ioutil.ReadAll(res.Body)
+	return res.StatusCode, string(b)
+}
+
+func TestDataAPI(t *testing.T) {
+	lru, err := tenure.New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	srv := httptest.NewServer(New(lru))
+	defer srv.Close()
+
+	if code, _ := do(t, srv, http.MethodPut, "/keys/user%2F1", "alice"); code != http.StatusOK {
+		t.Fatalf("PUT status mismatch; Have %v, Want %v", code, http.StatusOK)
+	}
+
+	if code, body := do(t, srv, http.MethodGet, "/keys/user%2F1", ""); code != http.StatusOK || body != "alice" {
+		t.Fatalf("GET mismatch; Have (%v, %q), Want (200, alice)", code, body)
+	}
+
+	if code, _ := do(t, srv, http.MethodGet, "/keys/missing", ""); code != http.StatusNotFound {
+		t.Fatalf("GET status mismatch; Have %v, Want %v", code, http.StatusNotFound)
+	}
+
+	_, body := do(t, srv, http.MethodGet, "/keys", "")
+	var keys []string
+	if json.Unmarshal([]byte(body), &keys); len(keys) != 1 || keys[0] != "user/1" {
+		t.Fatalf("Key listing mismatch; Have %v", keys)
+	}
+
+	if code, _ := do(t, srv, http.MethodDelete, "/keys/user%2F1", ""); code != http.StatusNoContent {
+		t.Fatalf("DELETE status mismatch; Have %v, Want %v", code, http.StatusNoContent)
+	}
+
+	if lru.Size() != 0 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", lru.Size(), 0)
+	}
+}
+
+func TestAdminAPI(t *testing.T) {
+	lru, err := tenure.New(4, nil, tenure.WithRedactor(func(k, v interface{}) (interface{}, interface{}) {
+		return k, "***"
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWith("a", "secret", tenure.Provenance([]byte("db")))
+	lru.Put("b", "2")
+
+	srv := httptest.NewServer(New(lru))
+	defer srv.Close()
+
+	_, body := do(t, srv, http.MethodGet, "/entries/a", "")
+	var info entryInfo
+	if json.Unmarshal([]byte(body), &info); info.Value != "***" || info.Provenance != "db" {
+		t.Fatalf("Entry metadata mismatch; Have %+v", info)
+	}
+
+	if code, body := do(t, srv, http.MethodPut, "/capacity", "1"); code != http.StatusOK || !strings.Contains(body, `"evicted":1`) {
+		t.Fatalf("Capacity adjustment mismatch; Have (%v, %s)", code, body)
+	}
+
+	_, body = do(t, srv, http.MethodGet, "/stats", "")
+	var s tenure.Stats
+	if json.Unmarshal([]byte(body), &s); s.Capacity != 1 || s.ListLen != 1 {
+		t.Fatalf("Stats mismatch; Have %+v", s)
+	}
+
+	if code, _ := do(t, srv, http.MethodPut, "/capacity", "zero"); code != http.StatusBadRequest {
+		t.Fatalf("Capacity validation mismatch; Have %v, Want %v", code, http.StatusBadRequest)
+	}
+}
+
+func TestSlowLogAPI(t *testing.T) {
+	lru, err := tenure.New(1, func(k, v interface{}) {
+		time.Sleep(2 * time.Millisecond)
+	}, tenure.WithSlowLog(tenure.SlowLogConfig{Callback: time.Millisecond}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+
+	srv := httptest.NewServer(New(lru))
+	defer srv.Close()
+
+	_, body := do(t, srv, http.MethodGet, "/slowlog", "")
+	var entries []slowEntry
+	if json.Unmarshal([]byte(body), &entries); len(entries) != 1 || entries[0].Key != "a" || !strings.Contains(body, `"op":"callback"`) {
+		t.Fatalf("Slow log mismatch; Have %s", body)
+	}
+
+	if code, _ := do(t, srv, http.MethodDelete, "/slowlog", ""); code != http.StatusNoContent || len(lru.SlowLog()) != 0 {
+		t.Fatalf("Slow log reset mismatch; Have %v", code)
+	}
+}
@@ -0,0 +1,209 @@
+// Package httpcache provides server-side middleware caching rendered responses in a tenure cache
+// Responses to GET and HEAD requests are cached per method, path, query and the values of the configured
+// Vary headers, for the TTL of the longest route prefix matching the request path. Only complete 200
+// responses are cached, and never those bearing a Set-Cookie header or a Cache-Control directive of
+// no-store or private. Served responses bear an X-Cache header of HIT or MISS
+package httpcache
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// defaultMaxBodySize bounds the size of cacheable response bodies, absent a configured bound
+const defaultMaxBodySize = 1 << 20
+
+// Config configures the middleware
+type Config struct {
+	// TTL is the lifetime of cached responses for paths matching no route; zero disables caching thereof
+	TTL time.Duration
+	// Routes maps path prefixes to the lifetime of cached responses for matching paths, the longest matching
+	// prefix taking precedence; a zero lifetime disables caching for the route
+	Routes map[string]time.Duration
+	// Vary lists the request headers whose values distinguish cached responses, e.g. Accept-Encoding
+	Vary []string
+	// MaxBodySize bounds the size of cacheable response bodies; it defaults to 1MiB
+	MaxBodySize int
+}
+
+// Cache is response caching middleware over an LRUCache
+type Cache struct {
+	c   *tenure.LRUCache
+	cfg Config
+}
+
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// New initializes new response caching middleware over `c`, which should be dedicated to it
+func New(c *tenure.LRUCache, cfg Config) *Cache {
+	if cfg.MaxBodySize <= 0 {
+		cfg.MaxBodySize = defaultMaxBodySize
+	}
+
+	for i, h := range cfg.Vary {
+		cfg.Vary[i] = http.CanonicalHeaderKey(h)
+	}
+
+	return &Cache{c: c, cfg: cfg}
+}
+
+// Handler wraps `next`, serving cached responses where extant and caching its responses where permissible
+func (hc *Cache) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ttl := hc.ttl(r.URL.Path)
+		if r.Method != http.MethodGet && r.Method != http.MethodHead || ttl <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key := hc.key(r)
+
+		if v, ok := hc.c.Get(key); ok {
+			res := v.(*response)
+
+			for k, vs := range res.header {
+				w.Header()[k] = vs
+			}
+			w.Header().Set("X-Cache", "HIT")
+			w.WriteHeader(res.status)
+			w.Write(res.body)
+			return
+		}
+
+		w.Header().Set("X-Cache", "MISS")
+
+		rec := &recorder{ResponseWriter: w, status: http.StatusOK, limit: hc.cfg.MaxBodySize}
+		next.ServeHTTP(rec, r)
+
+		if rec.cacheable() {
+			header := w.Header().Clone()
+			header.Del("X-Cache")
+
+			hc.c.PutWithTTL(key, &response{status: rec.status, header: header, body: rec.body.Bytes()}, 0, ttl)
+		}
+	})
+}
+
+// Invalidate removes every cached response to requests for exactly `path`, across methods, queries and variants
+func (hc *Cache) Invalidate(path string) (n int) {
+	return hc.invalidate(func(p string) bool { return p == path })
+}
+
+// InvalidatePrefix removes every cached response to requests for paths bearing `prefix`
+func (hc *Cache) InvalidatePrefix(prefix string) (n int) {
+	return hc.invalidate(func(p string) bool { return strings.HasPrefix(p, prefix) })
+}
+
+/* Utilities */
+
+func (hc *Cache) invalidate(match func(path string) bool) (n int) {
+	for _, k := range hc.c.Keys() {
+		key, ok := k.(string)
+		if !ok {
+			continue
+		}
+
+		// keys take the form "METHOD path?query\x00vary..."
+		target := strings.SplitN(strings.SplitN(key, "\x00", 2)[0], " ", 2)
+		if len(target) != 2 {
+			continue
+		}
+
+		if match(strings.SplitN(target[1], "?", 2)[0]) && hc.c.Del(key) {
+			n++
+		}
+	}
+
+	return
+}
+
+func (hc *Cache) ttl(path string) time.Duration {
+	ttl, longest := hc.cfg.TTL, -1
+
+	for prefix, d := range hc.cfg.Routes {
+		if len(prefix) > longest && strings.HasPrefix(path, prefix) {
+			ttl, longest = d, len(prefix)
+		}
+	}
+
+	return ttl
+}
+
+func (hc *Cache) key(r *http.Request) string {
+	var b strings.Builder
+
+	b.WriteString(r.Method)
+	b.WriteByte(' ')
+	b.WriteString(r.URL.Path)
+	if r.URL.RawQuery != "" {
+		b.WriteByte('?')
+		b.WriteString(r.URL.RawQuery)
+	}
+
+	for _, h := range hc.cfg.Vary {
+		b.WriteByte(0)
+		b.WriteString(strings.Join(r.Header.Values(h), ","))
+	}
+
+	return b.String()
+}
+
+// recorder relays a response to the client while capturing it for caching
+type recorder struct {
+	http.ResponseWriter
+	status   int
+	wrote    bool
+	body     bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	if !rec.wrote {
+		rec.status, rec.wrote = status, true
+	}
+
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	rec.wrote = true
+
+	if !rec.overflow {
+		if rec.body.Len()+len(b) > rec.limit {
+			rec.overflow = true
+			rec.body.Reset()
+		} else {
+			rec.body.Write(b)
+		}
+	}
+
+	return rec.ResponseWriter.Write(b)
+}
+
+func (rec *recorder) cacheable() bool {
+	if rec.status != http.StatusOK || rec.overflow {
+		return false
+	}
+
+	h := rec.Header()
+	if h.Get("Set-Cookie") != "" {
+		return false
+	}
+
+	for _, directive := range strings.Split(strings.ToLower(h.Get("Cache-Control")), ",") {
+		if d := strings.TrimSpace(directive); d == "no-store" || d == "private" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,114 @@
+package httpcache
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+func get(t *testing.T, h http.Handler, path string, header ...string) (string, string) {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	body, _ := ioutil.ReadAll(rec.Result().Body)
+	return string(body), rec.Header().Get("X-Cache")
+}
+
+func TestResponseCaching(t *testing.T) {
+	lru, err := tenure.New(16, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	var renders int
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		renders++
+		if r.URL.Path == "/private" {
+			w.Header().Set("Cache-Control", "private, max-age=60")
+		}
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, "%s %s #%d", r.URL.Path, r.Header.Get("Accept-Language"), renders)
+	})
+
+	hc := New(lru, Config{
+		TTL:    time.Minute,
+		Routes: map[string]time.Duration{"/live": 0, "/live/cached": time.Minute},
+		Vary:   []string{"accept-language"},
+	})
+	h := hc.Handler(next)
+
+	if body, x := get(t, h, "/a"); body != "/a  #1" || x != "MISS" {
+		t.Fatalf("Miss mismatch; Have (%q, %q)", body, x)
+	}
+
+	if body, x := get(t, h, "/a"); body != "/a  #1" || x != "HIT" {
+		t.Fatalf("Hit mismatch; Have (%q, %q)", body, x)
+	}
+
+	if body, _ := get(t, h, "/a", "Accept-Language", "fr"); body != "/a fr #2" {
+		t.Fatalf("Vary headers should distinguish responses; Have %q", body)
+	}
+
+	get(t, h, "/live/feed")
+	if _, x := get(t, h, "/live/feed"); x != "" {
+		t.Fatal("Routes with a zero TTL should not be cached")
+	}
+
+	get(t, h, "/live/cached")
+	if _, x := get(t, h, "/live/cached"); x != "HIT" {
+		t.Fatal("The longest matching route should take precedence")
+	}
+
+	for _, path := range []string{"/private", "/missing"} {
+		get(t, h, path)
+		if _, x := get(t, h, path); x != "MISS" {
+			t.Fatalf("Uncacheable responses should not be cached; Have %q for %v", x, path)
+		}
+	}
+
+	get(t, h, "/a?page=2")
+	if n := hc.Invalidate("/a"); n != 3 {
+		t.Fatalf("Invalidation should remove every variant; Have %v, Want %v", n, 3)
+	}
+
+	if _, x := get(t, h, "/a"); x != "MISS" {
+		t.Fatal("Invalidated responses should not be served")
+	}
+
+	if n := hc.InvalidatePrefix("/live/"); n != 1 {
+		t.Fatalf("Prefix invalidation mismatch; Have %v, Want %v", n, 1)
+	}
+}
+
+func TestResponseCachingSkipsLargeBodies(t *testing.T) {
+	lru, err := tenure.New(16, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	h := New(lru, Config{TTL: time.Minute, MaxBodySize: 4}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("abc"))
+		w.Write([]byte("def"))
+	}))
+
+	if body, _ := get(t, h, "/"); body != "abcdef" {
+		t.Fatalf("Large bodies should be relayed intact; Have %q", body)
+	}
+
+	if _, x := get(t, h, "/"); x != "MISS" {
+		t.Fatal("Bodies exceeding the bound should not be cached")
+	}
+}
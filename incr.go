@@ -0,0 +1,61 @@
+package tenure
+
+import "errors"
+
+// ErrWrongType is returned where an operation is applied to a value of a type it does not support
+var ErrWrongType = errors.New("value is of the wrong type for the operation")
+
+// IncrBy atomically adds `delta` to the int64 value for the given key, creating the entry at `delta` if absent,
+// and returns the value thereby stored; a negative `delta` decrements the value. Returns ErrWrongType, leaving the
+// entry as is, should the extant value be other than an int64, or else any error of Update
+// Expired and negative entries are reported as absent. The entry is stored as by PutWith, per `opts`
+func (lc *LRUCache) IncrBy(key interface{}, delta int64, opts ...EntryOption) (value int64, err error) {
+	var wrongType bool
+
+	_, err = lc.Update(key, func(old interface{}, exists bool) (interface{}, bool) {
+		if !exists {
+			value, wrongType = delta, false
+			return value, true
+		}
+
+		n, ok := old.(int64)
+		if wrongType = !ok; wrongType {
+			return nil, false
+		}
+
+		value = n + delta
+		return value, true
+	}, opts...)
+
+	if err == nil && wrongType {
+		err = ErrWrongType
+	}
+
+	return
+}
+
+// IncrByFloat atomically adds `delta` to the float64 value for the given key, as does IncrBy for int64 values
+func (lc *LRUCache) IncrByFloat(key interface{}, delta float64, opts ...EntryOption) (value float64, err error) {
+	var wrongType bool
+
+	_, err = lc.Update(key, func(old interface{}, exists bool) (interface{}, bool) {
+		if !exists {
+			value, wrongType = delta, false
+			return value, true
+		}
+
+		n, ok := old.(float64)
+		if wrongType = !ok; wrongType {
+			return nil, false
+		}
+
+		value = n + delta
+		return value, true
+	}, opts...)
+
+	if err == nil && wrongType {
+		err = ErrWrongType
+	}
+
+	return
+}
@@ -0,0 +1,65 @@
+package tenure
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestIncrBy(t *testing.T) {
+	lru, _ := New(3, nil)
+
+	if n, err := lru.IncrBy("hits", 5); n != 5 || err != nil {
+		t.Fatalf("IncrBy should create an absent entry at delta; Have %v, %v", n, err)
+	}
+
+	if n, err := lru.IncrBy("hits", -2); n != 3 || err != nil {
+		t.Fatalf("IncrBy should add delta to the extant value; Have %v, %v", n, err)
+	}
+
+	if v, _ := lru.Get("hits"); v != int64(3) {
+		t.Errorf("Value mismatch; Have %v, Want %v", v, 3)
+	}
+
+	lru.Put("name", "tenure")
+	if _, err := lru.IncrBy("name", 1); err != ErrWrongType {
+		t.Errorf("IncrBy of a non-integer value should fail; Have %v, Want %v", err, ErrWrongType)
+	}
+
+	if v, _ := lru.Get("name"); v != "tenure" {
+		t.Errorf("A failed IncrBy should leave the entry as is; Have %v", v)
+	}
+}
+
+func TestIncrByFloat(t *testing.T) {
+	lru, _ := New(3, nil)
+
+	lru.IncrByFloat("load", 0.5)
+	if f, err := lru.IncrByFloat("load", 0.25); f != 0.75 || err != nil {
+		t.Errorf("IncrByFloat should add delta to the extant value; Have %v, %v", f, err)
+	}
+
+	lru.IncrBy("hits", 1)
+	if _, err := lru.IncrByFloat("hits", 1); err != ErrWrongType {
+		t.Errorf("IncrByFloat of a non-float value should fail; Have %v, Want %v", err, ErrWrongType)
+	}
+}
+
+func TestIncrByConcurrent(t *testing.T) {
+	lru, _ := New(3, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				lru.IncrBy("n", 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if v, _ := lru.Get("n"); v != int64(800) {
+		t.Errorf("Concurrent increments should not be lost; Have %v, Want %v", v, 800)
+	}
+}
@@ -0,0 +1,106 @@
+// Package consistent implements a consistent hash ring with virtual nodes
+package consistent
+
+import (
+	"hash/crc32"
+	"sort"
+	"strconv"
+)
+
+// Hash maps data to a point on the ring
+type Hash func(data []byte) uint32
+
+// Ring distributes keys across nodes such that adding or removing a node only remaps the keys adjacent to it
+// Each node is placed on the ring at `replicas` virtual points to even out the distribution
+// A Ring is not safe for concurrent mutation
+type Ring struct {
+	hash     Hash
+	replicas int
+	points   []uint32
+	owners   map[uint32]string
+	nodes    map[string]struct{}
+}
+
+// New initializes a new, empty Ring; `fn` defaults to CRC-32 if nil
+func New(replicas int, fn Hash) *Ring {
+	if replicas <= 0 {
+		replicas = 1
+	}
+
+	if fn == nil {
+		fn = crc32.ChecksumIEEE
+	}
+
+	return &Ring{
+		hash:     fn,
+		replicas: replicas,
+		owners:   make(map[uint32]string),
+		nodes:    make(map[string]struct{}),
+	}
+}
+
+// Add places the given nodes on the ring
+func (r *Ring) Add(nodes ...string) {
+	for _, node := range nodes {
+		if _, ok := r.nodes[node]; ok {
+			continue
+		}
+
+		r.nodes[node] = struct{}{}
+		for i := 0; i < r.replicas; i++ {
+			p := r.hash([]byte(strconv.Itoa(i) + node))
+			r.points = append(r.points, p)
+			r.owners[p] = node
+		}
+	}
+
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+}
+
+// Remove removes the given nodes from the ring
+func (r *Ring) Remove(nodes ...string) {
+	for _, node := range nodes {
+		delete(r.nodes, node)
+	}
+
+	points := r.points[:0]
+	for _, p := range r.points {
+		if _, ok := r.nodes[r.owners[p]]; ok {
+			points = append(points, p)
+		} else {
+			delete(r.owners, p)
+		}
+	}
+
+	r.points = points
+}
+
+// Get returns the node owning the given key, or an empty string if the ring is empty
+func (r *Ring) Get(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+
+	h := r.hash([]byte(key))
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= h })
+	if i == len(r.points) {
+		i = 0
+	}
+
+	return r.owners[r.points[i]]
+}
+
+// Nodes returns the nodes currently on the ring, in no particular order
+func (r *Ring) Nodes() []string {
+	nodes := make([]string, 0, len(r.nodes))
+	for n := range r.nodes {
+		nodes = append(nodes, n)
+	}
+
+	return nodes
+}
+
+// Len returns the number of nodes on the ring
+func (r *Ring) Len() int {
+	return len(r.nodes)
+}
@@ -0,0 +1,39 @@
+package consistent
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRingStability(t *testing.T) {
+	r := New(64, nil)
+	r.Add("a", "b", "c")
+
+	before := make(map[string]string)
+	for i := 0; i < 1000; i++ {
+		k := fmt.Sprint(i)
+		before[k] = r.Get(k)
+	}
+
+	r.Remove("c")
+
+	for k, owner := range before {
+		if owner != "c" && r.Get(k) != owner {
+			t.Fatalf("Removing a node should only remap its own keys; %v moved from %v to %v", k, owner, r.Get(k))
+		}
+
+		if r.Get(k) == "c" {
+			t.Fatalf("Removed node still owns key %v", k)
+		}
+	}
+
+	if r.Len() != 2 {
+		t.Fatalf("Node count mismatch; Have %v, Want %v", r.Len(), 2)
+	}
+}
+
+func TestEmptyRing(t *testing.T) {
+	if n := New(1, nil).Get("a"); n != "" {
+		t.Fatalf("Empty ring should own nothing; Have %v", n)
+	}
+}
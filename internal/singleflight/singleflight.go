@@ -0,0 +1,74 @@
+// Package singleflight de-duplicates concurrent invocations of a function for the same key
+package singleflight
+
+import (
+	"errors"
+	"sync"
+)
+
+// errGoexit is returned to the waiters of a call whose function exited its goroutine by runtime.Goexit
+var errGoexit = errors.New("singleflight: call exited without returning")
+
+// Group de-duplicates concurrent calls per key; its zero value is ready for use
+type Group struct {
+	lock  sync.Mutex
+	calls map[interface{}]*call
+}
+
+type call struct {
+	wg  sync.WaitGroup
+	val interface{}
+	err error
+	// panicked is set should the function panic, bearing the recovered value in `recovered`
+	panicked  bool
+	recovered interface{}
+}
+
+// Do invokes `fn` and returns its results, unless a call for the same key is already in flight, in which case
+// it waits for that call and returns its results instead. Keys must be comparable
+// Should `fn` panic, the panic is propagated to the caller and every waiter alike
+func (g *Group) Do(key interface{}, fn func() (interface{}, error)) (interface{}, error) {
+	g.lock.Lock()
+	if g.calls == nil {
+		g.calls = make(map[interface{}]*call)
+	}
+
+	if c, ok := g.calls[key]; ok {
+		g.lock.Unlock()
+		c.wg.Wait()
+		if c.panicked {
+			panic(c.recovered)
+		}
+		return c.val, c.err
+	}
+
+	c := &call{}
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.lock.Unlock()
+
+	returned := false
+	defer func() {
+		if !returned {
+			if c.recovered = recover(); c.recovered != nil {
+				c.panicked = true
+			} else {
+				c.err = errGoexit
+			}
+		}
+
+		g.lock.Lock()
+		delete(g.calls, key)
+		g.lock.Unlock()
+		c.wg.Done()
+
+		if c.panicked {
+			panic(c.recovered)
+		}
+	}()
+
+	c.val, c.err = fn()
+	returned = true
+
+	return c.val, c.err
+}
@@ -0,0 +1,100 @@
+package singleflight
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoDeduplicates(t *testing.T) {
+	var g Group
+	var calls int32
+	release := make(chan struct{})
+	entered := make(chan struct{})
+
+	go func() {
+		g.Do("a", func() (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+			close(entered)
+			<-release
+			return 1, nil
+		})
+	}()
+	<-entered
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 4)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = g.Do("a", func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				return 2, nil
+			})
+		}(i)
+	}
+
+	// Allow the waiters to join the call in flight before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("Concurrent calls should be de-duplicated; Have %v calls, Want %v", n, 1)
+	}
+
+	for _, v := range results {
+		if v != 1 {
+			t.Fatalf("Waiters should share the results of the call in flight; Have %v, Want %v", v, 1)
+		}
+	}
+}
+
+func TestDoSharesErrors(t *testing.T) {
+	var g Group
+	want := errors.New("failed")
+
+	if _, err := g.Do("a", func() (interface{}, error) { return nil, want }); err != want {
+		t.Fatalf("Error mismatch; Have %v, Want %v", err, want)
+	}
+
+	if v, err := g.Do("a", func() (interface{}, error) { return 1, nil }); err != nil || v != 1 {
+		t.Fatalf("Completed calls should not be shared; Have (%v, %v), Want (1, nil)", v, err)
+	}
+}
+
+func TestDoPropagatesPanics(t *testing.T) {
+	var g Group
+	release := make(chan struct{})
+	entered := make(chan struct{})
+
+	go func() {
+		defer func() { recover() }()
+		g.Do("a", func() (interface{}, error) {
+			close(entered)
+			<-release
+			panic("failed")
+		})
+	}()
+	<-entered
+
+	waited := make(chan interface{})
+	go func() {
+		defer func() { waited <- recover() }()
+		g.Do("a", func() (interface{}, error) { return 1, nil })
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	if r := <-waited; r != "failed" {
+		t.Fatalf("Waiters should receive the panic of the call in flight; Have %v, Want %v", r, "failed")
+	}
+
+	if v, err := g.Do("a", func() (interface{}, error) { return 2, nil }); err != nil || v != 2 {
+		t.Fatalf("Calls after a panic should proceed anew; Have (%v, %v), Want (2, nil)", v, err)
+	}
+}
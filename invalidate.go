@@ -0,0 +1,111 @@
+package tenure
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+)
+
+// Invalidation is a message announcing that a key has been written or deleted by the cache designated by Origin
+type Invalidation struct {
+	Origin string
+	Key    interface{}
+}
+
+// Invalidator is a publish / subscribe bus over which replicas of a cache exchange invalidations
+// Implementations may wrap NATS, Kafka, Redis pub/sub and the like; see LocalBus for an in-process bus
+// Keys must survive the bus's encoding, so string keys are advised for buses that cross process boundaries
+type Invalidator interface {
+	Publish(msg Invalidation) error
+	Subscribe(fn func(msg Invalidation)) (cancel func(), err error)
+}
+
+// WithInvalidator configures the cache to publish an invalidation to `inv` on every Put and Del, and to drop
+// any key invalidated by another replica, keeping multi-replica services coherent
+// Errors encountered while publishing are reported to `onError`, if non-nil
+// Close must be invoked to cancel the cache's subscription
+func WithInvalidator(inv Invalidator, onError func(err error)) Option {
+	return func(lc *LRUCache) {
+		lc.invalidator = inv
+		lc.onPubError = onError
+	}
+}
+
+// LocalBus is an in-process Invalidator, fanning out every invalidation to all subscribers synchronously
+type LocalBus struct {
+	lock sync.RWMutex
+	subs map[int]func(Invalidation)
+	next int
+}
+
+// NewLocalBus initializes a new, empty LocalBus
+func NewLocalBus() *LocalBus {
+	return &LocalBus{subs: make(map[int]func(Invalidation))}
+}
+
+// Publish delivers the given invalidation to every subscriber
+func (lb *LocalBus) Publish(msg Invalidation) error {
+	lb.lock.RLock()
+	subs := make([]func(Invalidation), 0, len(lb.subs))
+	for _, fn := range lb.subs {
+		subs = append(subs, fn)
+	}
+	lb.lock.RUnlock()
+
+	for _, fn := range subs {
+		fn(msg)
+	}
+
+	return nil
+}
+
+// Subscribe registers `fn` to receive every subsequently published invalidation
+func (lb *LocalBus) Subscribe(fn func(msg Invalidation)) (cancel func(), err error) {
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+
+	id := lb.next
+	lb.next++
+	lb.subs[id] = fn
+
+	return func() {
+		lb.lock.Lock()
+		defer lb.lock.Unlock()
+
+		delete(lb.subs, id)
+	}, nil
+}
+
+/* Utilities */
+
+func (lc *LRUCache) subscribe() error {
+	var b [8]byte
+	rand.Read(b[:])
+	lc.origin = hex.EncodeToString(b[:])
+
+	cancel, err := lc.invalidator.Subscribe(func(msg Invalidation) {
+		if msg.Origin != lc.origin {
+			lc.remove(msg.Key)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	lc.closers = append(lc.closers, func() error {
+		cancel()
+		return nil
+	})
+
+	return nil
+}
+
+func (lc *LRUCache) publish(key interface{}) {
+	if lc.invalidator == nil {
+		return
+	}
+
+	if err := lc.invalidator.Publish(Invalidation{Origin: lc.origin, Key: key}); err != nil && lc.onPubError != nil {
+		lc.onPubError(err)
+	}
+}
@@ -0,0 +1,49 @@
+package tenure
+
+import (
+	"testing"
+)
+
+func TestInvalidationBus(t *testing.T) {
+	bus := NewLocalBus()
+
+	replicas := make([]*LRUCache, 3)
+	for i := range replicas {
+		lru, err := New(8, nil, WithInvalidator(bus, nil))
+		if err != nil {
+			t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+		}
+		defer lru.Close()
+
+		lru.Put("a", i)
+		replicas[i] = lru
+	}
+
+	// Each Put above invalidated the key on every previously initialized replica
+	for i, lru := range replicas[:2] {
+		if lru.Has("a") {
+			t.Fatalf("Replica %v should have dropped the invalidated key", i)
+		}
+	}
+
+	if v, ok := replicas[2].Get("a"); !ok || v != 2 {
+		t.Fatalf("Publishing replicas should retain their own writes; Have (%v, %v), Want (2, true)", v, ok)
+	}
+
+	replicas[0].Put("b", 1)
+	replicas[1].Put("b", 1)
+	replicas[2].Del("b")
+
+	if replicas[0].Has("b") || replicas[1].Has("b") {
+		t.Fatal("Deletions should invalidate every replica")
+	}
+
+	replicas[0].Close()
+	replicas[1].Put("c", 1)
+	replicas[0].Put("c", 0)
+	replicas[2].Put("c", 2)
+
+	if !replicas[0].Has("c") {
+		t.Fatal("Closed replicas should no longer receive invalidations")
+	}
+}
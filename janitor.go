@@ -0,0 +1,135 @@
+package tenure
+
+import "time"
+
+// janitorCheckEvery is the number of entries removed between checks of a sweep's budget
+const janitorCheckEvery = 32
+
+// JanitorConfig configures the pacing of the expiration janitor; see WithJanitor
+type JanitorConfig struct {
+	// MinInterval is the shortest pause between sweeps, adopted under heavy expiration churn
+	MinInterval time.Duration
+	// MaxInterval is the longest pause between sweeps, adopted while nothing is expiring
+	MaxInterval time.Duration
+	// Budget is the maximum time a single sweep may hold the cache's lock
+	Budget time.Duration
+}
+
+// WithJanitor configures the cache to remove hard-expired entries in the background, rather than
+// only upon access. Entries are drawn from a heap ordered by expiry, so a sweep costs time proportional to
+// the number of expired entries rather than to the size of the cache. Each sweep yields once its Budget is
+// spent, leaving the remainder to the next. The janitor paces itself by the expired entries each sweep finds:
+// it halves its pause while sweeps exhaust their Budget, and doubles it while they find none, within
+// [MinInterval, MaxInterval]. Close must be invoked to halt the janitor
+func WithJanitor(cfg JanitorConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.MinInterval <= 0 {
+			cfg.MinInterval = time.Second
+		}
+
+		if cfg.MaxInterval < cfg.MinInterval {
+			cfg.MaxInterval = 60 * cfg.MinInterval
+		}
+
+		if cfg.Budget <= 0 {
+			cfg.Budget = time.Millisecond
+		}
+
+		lc.janitor = &janitor{cfg: cfg, interval: cfg.MaxInterval}
+	}
+}
+
+/* Utilities */
+
+type janitor struct {
+	cfg      JanitorConfig
+	lc       *LRUCache
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func (j *janitor) start(lc *LRUCache) {
+	j.lc = lc
+	j.stop = make(chan struct{})
+	j.done = make(chan struct{})
+
+	go j.run()
+}
+
+func (j *janitor) run() {
+	defer close(j.done)
+
+	for d := j.interval; pause(j.lc.clock, d, j.stop); {
+		d = j.pace(j.sweep())
+	}
+}
+
+func (j *janitor) close() error {
+	select {
+	case <-j.stop:
+	default:
+		close(j.stop)
+		<-j.done
+	}
+
+	return nil
+}
+
+// pace adapts the pause before the next sweep to the expired entries the last sweep found, and whether it
+// exhausted its budget before removing them all
+func (j *janitor) pace(found int, exhausted bool) time.Duration {
+	switch {
+	case exhausted:
+		j.interval /= 2
+	case found == 0:
+		j.interval *= 2
+	}
+
+	if j.interval < j.cfg.MinInterval {
+		j.interval = j.cfg.MinInterval
+	}
+
+	if j.interval > j.cfg.MaxInterval {
+		j.interval = j.cfg.MaxInterval
+	}
+
+	return j.interval
+}
+
+// sweep removes hard-expired entries in order of expiry, until either no expired entry remains or the budget
+// is exhausted, reporting the number removed and whether the budget was exhausted; as entries are drawn from
+// the expiry heap, a sweep examines only those entries that have expired, and one more, however large the cache
+func (j *janitor) sweep() (found int, exhausted bool) {
+	lc := j.lc
+
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	start := time.Now()
+	now := lc.clock.Now()
+
+	for kv := lc.expiries.peek(); kv != nil; kv = lc.expiries.peek() {
+		if !kv.expired(now) {
+			break
+		}
+
+		// The budget is exhausted only should an expired entry remain
+		if found > 0 && found%janitorCheckEvery == 0 && time.Since(start) >= j.cfg.Budget {
+			exhausted = true
+			break
+		}
+
+		lc.purgeLRUItem(kv)
+		lc.observeLifetime(kv, true)
+		lc.emit(EventExpire, kv)
+		lc.release(kv)
+		found++
+	}
+
+	if found > 0 {
+		lc.maybeCompact()
+	}
+
+	return
+}
@@ -0,0 +1,106 @@
+package tenure
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestJanitorRemovesExpiredEntries(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(8, nil, WithClock(clock), WithJanitor(JanitorConfig{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	lru.PutWithTTL("a", 1, 0, time.Second)
+	lru.PutWithTTL("b", 2, 0, time.Hour)
+	lru.Put("c", 3)
+
+	// The janitor arms its next timer once its sweep is done
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	clock.BlockUntil(1)
+
+	if lru.Size() != 2 {
+		t.Fatalf("Janitor should remove expired entries; Have size %v, Want size %v", lru.Size(), 2)
+	}
+
+	if lru.TTLDistribution().Expired.Count != 1 {
+		t.Fatal("Swept entries should be recorded as expired")
+	}
+}
+
+func TestJanitorPacing(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	lru, _ := New(1000, nil, WithClock(clock))
+
+	j := &janitor{cfg: JanitorConfig{MinInterval: time.Second, MaxInterval: 8 * time.Second, Budget: time.Hour}, lc: lru, interval: 4 * time.Second}
+
+	if d := j.pace(j.sweep()); d != 8*time.Second {
+		t.Fatalf("Janitor should back off while idle; Have %v, Want %v", d, 8*time.Second)
+	}
+
+	if d := j.pace(j.sweep()); d != 8*time.Second {
+		t.Fatalf("Janitor should not exceed its maximum interval; Have %v, Want %v", d, 8*time.Second)
+	}
+
+	for i := 0; i < 10; i++ {
+		lru.PutWithTTL(i, i, 0, time.Second)
+	}
+	clock.Advance(time.Minute)
+
+	if d := j.pace(j.sweep()); d != 8*time.Second || lru.Size() != 0 {
+		t.Fatalf("Janitor should hold its pace while its sweeps keep up; Have %v, Want %v", d, 8*time.Second)
+	}
+
+	// A sweep exhausting its budget leaves expired entries to the next, which the janitor hastens
+	j.cfg.Budget = time.Nanosecond
+	for i := 0; i < 1000; i++ {
+		lru.PutWithTTL(i, i, 0, time.Second)
+	}
+	clock.Advance(time.Minute)
+
+	for i := 0; i < 4; i++ {
+		j.pace(j.sweep())
+	}
+
+	if j.interval != time.Second {
+		t.Fatalf("Janitor should speed up to its minimum interval under churn; Have %v, Want %v", j.interval, time.Second)
+	}
+}
+
+func TestJanitorSweepBudget(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(1000, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		lru.PutWithTTL(fmt.Sprint(i), i, 0, time.Second)
+	}
+
+	clock.Advance(time.Minute)
+
+	j := &janitor{cfg: JanitorConfig{Budget: time.Nanosecond}, lc: lru}
+
+	found, exhausted := j.sweep()
+	if !exhausted || found != janitorCheckEvery {
+		t.Fatalf("Sweep should yield once its budget is spent; Have (%v, %v), Want (%v, %v)", found, exhausted, janitorCheckEvery, true)
+	}
+
+	j.cfg.Budget = time.Hour
+	if found, _ := j.sweep(); found != 1000-janitorCheckEvery {
+		t.Fatalf("Sweep should resume where it left off; Have %v, Want %v", found, 1000-janitorCheckEvery)
+	}
+
+	if lru.Size() != 0 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", lru.Size(), 0)
+	}
+}
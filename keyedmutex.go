@@ -0,0 +1,64 @@
+package tenure
+
+import "sync"
+
+// KeyedMutex is a set of mutual exclusion locks, one per key, so that operations on a given key are
+// serialized while those on distinct keys proceed in parallel. Locks are allocated upon demand and released
+// once no goroutine holds or awaits them, so the set is bounded by the number of keys in contention
+// The zero value is an unlocked KeyedMutex, ready for use; a KeyedMutex must not be copied after first use
+type KeyedMutex struct {
+	lock  sync.Mutex
+	locks map[interface{}]*keyedLock
+}
+
+type keyedLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// Lock locks the mutex for `key`, blocking until it is available
+func (km *KeyedMutex) Lock(key interface{}) {
+	km.lock.Lock()
+	if km.locks == nil {
+		km.locks = make(map[interface{}]*keyedLock)
+	}
+
+	l, ok := km.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		km.locks[key] = l
+	}
+	l.refs++
+	km.lock.Unlock()
+
+	l.mu.Lock()
+}
+
+// Unlock unlocks the mutex for `key`
+// As with sync.Mutex, it is a run-time error if the mutex for `key` is not locked on entry to Unlock
+func (km *KeyedMutex) Unlock(key interface{}) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+
+	l, ok := km.locks[key]
+	if !ok {
+		panic("tenure: unlock of unlocked KeyedMutex key")
+	}
+
+	l.refs--
+	if l.refs == 0 {
+		delete(km.locks, key)
+	}
+
+	l.mu.Unlock()
+}
+
+/* Utilities */
+
+// len returns the number of keys whose locks are held or awaited
+func (km *KeyedMutex) len() int {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+
+	return len(km.locks)
+}
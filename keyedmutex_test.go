@@ -0,0 +1,97 @@
+package tenure
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	var km KeyedMutex
+
+	km.Lock("a")
+
+	acquired := make(chan struct{})
+	go func() {
+		km.Lock("a")
+		close(acquired)
+	}()
+
+	// Distinct keys must not contend
+	km.Lock("b")
+	km.Unlock("b")
+
+	select {
+	case <-acquired:
+		t.Fatal("The lock for a key should exclude other holders")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	km.Unlock("a")
+	<-acquired
+	km.Unlock("a")
+
+	if n := km.len(); n != 0 {
+		t.Fatalf("Released locks should be discarded; Have %v, Want %v", n, 0)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected a panic upon unlock of an unlocked key")
+		}
+	}()
+	km.Unlock("a")
+}
+
+// gatedStore counts loads, blocking each until released
+type gatedStore struct {
+	loads   int32
+	release chan struct{}
+}
+
+func (gs *gatedStore) Load(key interface{}) (interface{}, error) {
+	atomic.AddInt32(&gs.loads, 1)
+	<-gs.release
+	return key, nil
+}
+
+func (gs *gatedStore) Write(key, value interface{}) error { return nil }
+func (gs *gatedStore) Delete(key interface{}) error       { return nil }
+
+func TestGetOrLoadPerKeyLocking(t *testing.T) {
+	gs := &gatedStore{release: make(chan struct{})}
+
+	lru, err := New(8, nil, WithStore(gs))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "a", "a", "b", "c"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+
+			if v, err := lru.GetOrLoad(key); err != nil || v != key {
+				t.Errorf("Load mismatch; Have (%v, %v), Want (%v, <nil>)", v, err, key)
+			}
+		}(key)
+	}
+
+	// Loads of distinct keys proceed in parallel, and so all three are in flight at once
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&gs.loads) < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	close(gs.release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&gs.loads); n != 3 {
+		t.Fatalf("Concurrent loads of a key should be de-duplicated; Have %v loads, Want %v loads", n, 3)
+	}
+
+	if n := lru.loading.len(); n != 0 {
+		t.Fatalf("Key locks should be released; Have %v, Want %v", n, 0)
+	}
+}
@@ -0,0 +1,65 @@
+package tenure
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+)
+
+// HashedKey is the form in which keys are stored by a cache configured WithKeyHashing
+// Keys, LeastRecentlyUsed and eviction callbacks all surface keys in this form
+type HashedKey [sha256.Size]byte
+
+// WithKeyHashing configures the cache to store every key as its HMAC-SHA256 digest under `secret`
+// For caches keyed by secrets (API tokens, session IDs), this ensures raw secrets never sit in the
+// cache's map, and mitigates timing side channels via key comparison. Lookups accept raw keys as usual
+// A byte slice is hashed as the string of the same bytes, such that either form addresses the same entry
+// Other keys are hashed by their type and Go-syntax representation, and pointers by their type and address, such
+// that keys of distinct types, or distinct pointers, never share a digest, nor share one with any string
+func WithKeyHashing(secret []byte) Option {
+	return func(lc *LRUCache) {
+		lc.keySecret = append([]byte(nil), secret...)
+	}
+}
+
+/* Utilities */
+
+func (lc *LRUCache) mapKey(key interface{}) interface{} {
+	lc.debug.lintKey(lc, key)
+
+	if lc.keySecret == nil {
+		if lc.keys.handles(key) {
+			return lc.keys.find(key)
+		}
+		return key
+	}
+
+	// HashedKeys surfaced by the cache itself (e.g. via Keys) are already in stored form
+	if hk, ok := key.(HashedKey); ok {
+		return hk
+	}
+
+	mac := hmac.New(sha256.New, lc.keySecret)
+
+	// Each form is tagged, so that no string shares the input of a key of another type; byte slices are tagged as
+	// strings by design, being interchangeable with them
+	switch k := key.(type) {
+	case string:
+		mac.Write([]byte{'s'})
+		mac.Write([]byte(k))
+	case []byte:
+		mac.Write([]byte{'s'})
+		mac.Write(k)
+	default:
+		if reflect.ValueOf(k).Kind() == reflect.Ptr {
+			fmt.Fprintf(mac, "p%T\x00%p", k, k)
+		} else {
+			fmt.Fprintf(mac, "v%T\x00%#v", k, k)
+		}
+	}
+
+	var hk HashedKey
+	copy(hk[:], mac.Sum(nil))
+	return hk
+}
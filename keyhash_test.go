@@ -0,0 +1,62 @@
+package tenure
+
+import (
+	"testing"
+)
+
+func TestKeyHashing(t *testing.T) {
+	lru, err := New(4, nil, WithKeyHashing([]byte("pepper")))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	token := "sk_live_secret"
+	lru.Put(token, "alice")
+
+	if v, ok := lru.Get(token); !ok || v != "alice" {
+		t.Fatalf("Hashed key read failure; Have (%v, %v), Want (alice, true)", v, ok)
+	}
+
+	for _, k := range lru.Keys() {
+		if k == token {
+			t.Fatal("Raw secret should not be stored as a key")
+		}
+
+		if !lru.Has(k) {
+			t.Fatal("Surfaced hashed keys should be accepted for lookups")
+		}
+	}
+
+	if v, ok := lru.Get([]byte(token)); !ok || v != "alice" {
+		t.Fatalf("Byte slice keys should address the entry of the equivalent string; Have (%v, %v), Want (alice, true)", v, ok)
+	}
+
+	if !lru.Del([]byte(token)) {
+		t.Fatal("Byte slice keys should hash equivalently to strings")
+	}
+}
+
+func TestKeyHashingDistinguishesTypes(t *testing.T) {
+	lru, err := New(16, nil, WithKeyHashing([]byte("pepper")))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	type point struct{ X, Y int }
+	p, q := &point{1, 2}, &point{1, 2}
+
+	keys := []interface{}{int(1), int64(1), uint8(1), float64(1), "1", "int1", `"1"`, point{1, 2}, p, q}
+	for i, k := range keys {
+		lru.Put(k, i)
+	}
+
+	if lru.Size() != len(keys) {
+		t.Fatalf("Keys of distinct types should not collide; Have %v entries, Want %v", lru.Size(), len(keys))
+	}
+
+	for i, k := range keys {
+		if v, _ := lru.Get(k); v != i {
+			t.Errorf("Value of %#v mismatch; Have %v, Want %v", k, v, i)
+		}
+	}
+}
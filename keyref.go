@@ -0,0 +1,172 @@
+package tenure
+
+import (
+	"bytes"
+	"hash/maphash"
+	"sync"
+)
+
+// KeyRef is the form in which a cache stores keys that cannot serve as map keys, i.e. byte slices, and all
+// keys of a cache configured WithKeyFuncs
+// Such keys are matched by hash and equality rather than by the map itself, such that lookups by byte slice
+// neither convert nor copy the key; the key is copied only upon insertion, as the caller may reuse its buffer
+// Keys, LeastRecentlyUsed and eviction callbacks all surface such keys in this form, bearing the stored key
+// as Key; transactions accept a KeyRef in place of the key it bears
+type KeyRef struct {
+	Key  interface{}
+	hash uint64
+}
+
+// keyIndex matches keys stored by reference to their KeyRefs
+// It must be seeded before use
+type keyIndex struct {
+	seed maphash.Seed
+	// hashFn and eqFn, if set, match all keys in place of the byte slice defaults; see WithKeyFuncs
+	hashFn func(key interface{}) uint64
+	eqFn   func(a, b interface{}) bool
+	mu     sync.RWMutex
+	refs   map[uint64][]*KeyRef
+}
+
+// keyHash is the stand-in for keys matched by custom key functions; see (*keyIndex).token
+type keyHash uint64
+
+// WithKeyFuncs configures the cache to match keys by `hash` and `eq` rather than by Go equality, such that keys
+// of non-comparable types (e.g. structs bearing slices or maps), or keys with their own notion of equality, may
+// be cached. Keys deemed equal by `eq` must bear equal hashes. Every key is then stored as a KeyRef, and must
+// not be mutated once inserted, as the cache retains it as is
+// Custom key functions cannot be combined with key hashing, nor with write-behind, whose buffer requires
+// comparable keys
+func WithKeyFuncs(hash func(key interface{}) uint64, eq func(a, b interface{}) bool) Option {
+	return func(lc *LRUCache) {
+		lc.keys.hashFn, lc.keys.eqFn = hash, eq
+	}
+}
+
+/* Utilities */
+
+// handles reports whether `key` is stored by reference
+func (ix *keyIndex) handles(key interface{}) bool {
+	if _, ok := key.(*KeyRef); ok {
+		return false
+	}
+
+	if ix.hashFn != nil {
+		return true
+	}
+
+	_, ok := key.([]byte)
+	return ok
+}
+
+// find returns the KeyRef bound to a key equal to `key`, or else an unbound KeyRef bearing `key` as is
+func (ix *keyIndex) find(key interface{}) *KeyRef {
+	h := ix.hash(key)
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	for _, ref := range ix.refs[h] {
+		if ix.equal(ref.Key, key) {
+			return ref
+		}
+	}
+
+	return &KeyRef{Key: key, hash: h}
+}
+
+// bind returns the KeyRef bound to a key equal to that of `ref`, binding `ref` itself if there is none
+// Keys other than KeyRefs are returned as is. The cache's write lock must be held, such that a KeyRef is
+// never bound while its entry is being removed
+func (ix *keyIndex) bind(key interface{}) interface{} {
+	ref, ok := key.(*KeyRef)
+	if !ok {
+		return key
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	for _, bound := range ix.refs[ref.hash] {
+		if bound == ref || ix.equal(bound.Key, ref.Key) {
+			return bound
+		}
+	}
+
+	if b, ok := ref.Key.([]byte); ok {
+		ref.Key = append([]byte(nil), b...)
+	}
+
+	if ix.refs == nil {
+		ix.refs = make(map[uint64][]*KeyRef)
+	}
+	ix.refs[ref.hash] = append(ix.refs[ref.hash], ref)
+
+	return ref
+}
+
+// unbind releases the KeyRef of a removed entry, if its key is one
+func (ix *keyIndex) unbind(key interface{}) {
+	ref, ok := key.(*KeyRef)
+	if !ok {
+		return
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	refs := ix.refs[ref.hash]
+	for i, bound := range refs {
+		if bound == ref {
+			refs[i] = refs[len(refs)-1]
+			refs[len(refs)-1] = nil
+			refs = refs[:len(refs)-1]
+			break
+		}
+	}
+
+	if len(refs) == 0 {
+		delete(ix.refs, ref.hash)
+	} else {
+		ix.refs[ref.hash] = refs
+	}
+}
+
+// token returns a comparable stand-in for a raw or mapped key, equal for equal keys, by which auxiliary
+// structures (e.g. the ghost list, or the write-behind buffer) may key entries stored by reference
+// Under custom key functions the stand-in is the key's hash, which unequal keys may share
+func (ix *keyIndex) token(key interface{}) interface{} {
+	if ref, ok := key.(*KeyRef); ok {
+		key = ref.Key
+	}
+
+	if ix.hashFn != nil {
+		return keyHash(ix.hashFn(key))
+	}
+
+	if b, ok := key.([]byte); ok {
+		return string(b)
+	}
+
+	return key
+}
+
+func (ix *keyIndex) hash(key interface{}) uint64 {
+	if ix.hashFn != nil {
+		return ix.hashFn(key)
+	}
+
+	var h maphash.Hash
+	h.SetSeed(ix.seed)
+	h.Write(key.([]byte))
+
+	return h.Sum64()
+}
+
+func (ix *keyIndex) equal(a, b interface{}) bool {
+	if ix.eqFn != nil {
+		return ix.eqFn(a, b)
+	}
+
+	return bytes.Equal(a.([]byte), b.([]byte))
+}
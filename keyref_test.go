@@ -0,0 +1,129 @@
+package tenure
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestByteSliceKeys(t *testing.T) {
+	var evicted []interface{}
+
+	lru, err := New(2, func(key, value interface{}) {
+		evicted = append(evicted, key)
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	buf := []byte("alpha")
+	lru.Put(buf, 1)
+	copy(buf, "gamma")
+
+	if v, ok := lru.Get([]byte("alpha")); !ok || v != 1 {
+		t.Fatalf("Lookup by an equal byte slice mismatch; Have %v, Want %v", v, 1)
+	}
+
+	if lru.Has(buf) {
+		t.Fatal("Reuse of the caller's buffer should not affect the stored key")
+	}
+
+	lru.Put([]byte("alpha"), 2)
+	if lru.Size() != 1 {
+		t.Fatalf("Equal byte slices should share an entry; Have size %v, Want %v", lru.Size(), 1)
+	}
+
+	ref, ok := lru.Keys()[0].(*KeyRef)
+	if !ok || !bytes.Equal(ref.Key.([]byte), []byte("alpha")) {
+		t.Fatalf("Keys should surface byte slice keys as KeyRefs; Have %v", lru.Keys()[0])
+	}
+
+	if v := lru.Peek(ref); v != 2 {
+		t.Fatalf("Lookup by KeyRef mismatch; Have %v, Want %v", v, 2)
+	}
+
+	lru.Put([]byte("beta"), 3)
+	lru.Put([]byte("delta"), 4)
+
+	if len(evicted) != 1 || evicted[0] != ref {
+		t.Fatalf("Eviction callback should receive the evicted KeyRef; Have %v", evicted)
+	}
+
+	lru.Del([]byte("beta"))
+	lru.Del([]byte("delta"))
+
+	if n := len(lru.keys.refs); n != 0 {
+		t.Fatalf("Removed entries should release their KeyRefs; Have %v, Want %v", n, 0)
+	}
+}
+
+func TestByteSliceLookupAllocations(t *testing.T) {
+	lru, err := New(8, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	var key interface{} = []byte("key")
+	lru.Put(key, "value")
+
+	if n := testing.AllocsPerRun(100, func() { lru.Get(key) }); n != 0 {
+		t.Fatalf("Lookups by byte slice should not allocate; Have %v allocs, Want %v", n, 0)
+	}
+}
+
+type compositeKey struct {
+	tenant string
+	path   []string
+}
+
+func TestKeyFuncs(t *testing.T) {
+	hash := func(key interface{}) uint64 {
+		k := key.(compositeKey)
+		h := uint64(len(k.tenant))
+		for _, p := range k.path {
+			h = h*31 + uint64(len(p))
+		}
+		return h
+	}
+
+	eq := func(a, b interface{}) bool {
+		ka, kb := a.(compositeKey), b.(compositeKey)
+		if ka.tenant != kb.tenant || len(ka.path) != len(kb.path) {
+			return false
+		}
+		for i := range ka.path {
+			if ka.path[i] != kb.path[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	lru, err := New(8, nil, WithKeyFuncs(hash, eq))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put(compositeKey{"a", []string{"x", "y"}}, 1)
+	lru.Put(compositeKey{"a", []string{"y", "x"}}, 2)
+	lru.Put(compositeKey{"a", []string{"x", "y"}}, 3)
+
+	if lru.Size() != 2 {
+		t.Fatalf("Keys deemed equal should share an entry; Have size %v, Want %v", lru.Size(), 2)
+	}
+
+	if v, ok := lru.Get(compositeKey{"a", []string{"x", "y"}}); !ok || v != 3 {
+		t.Fatalf("Lookup by an equal key mismatch; Have %v, Want %v", v, 3)
+	}
+
+	if lru.Has(compositeKey{"b", []string{"x", "y"}}) {
+		t.Fatal("Keys colliding in hash alone should not match")
+	}
+
+	if !lru.Del(compositeKey{"a", []string{"y", "x"}}) || lru.Size() != 1 {
+		t.Fatal("Del should remove the entry matched by the key functions")
+	}
+
+	if _, err := New(8, nil, WithKeyFuncs(hash, eq), WithKeyHashing([]byte("secret"))); err == nil {
+		t.Fatal("Expected an error for custom key functions combined with key hashing")
+	}
+}
@@ -0,0 +1,93 @@
+package tenure
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// KeyStats reports the usage statistics of a single key; see WithKeyStats
+type KeyStats struct {
+	Hits       uint64
+	Misses     uint64
+	LastAccess time.Time
+}
+
+// WithKeyStats configures the cache to track the hits, misses and last access of individual keys, exposed via
+// KeyStats. Every lookup, hit or miss, counts as an access. Statistics are retained for at most as many keys
+// as the cache's capacity, those of the least recently accessed keys being discarded first, such that the
+// table is bounded as is the cache itself; statistics survive the eviction of their key until so discarded
+func WithKeyStats() Option {
+	return func(lc *LRUCache) {
+		lc.keyStats = &keyStats{links: list.New(), index: make(map[interface{}]*list.Element)}
+	}
+}
+
+// KeyStats returns the usage statistics of the given key
+// The boolean flag is false if the key's statistics are not retained, or the cache is not configured WithKeyStats
+func (lc *LRUCache) KeyStats(key interface{}) (stats KeyStats, ok bool) {
+	if lc.keyStats == nil {
+		return KeyStats{}, false
+	}
+
+	return lc.keyStats.get(lc.keys.token(lc.mapKey(key)))
+}
+
+// keyStats is a table of per-key statistics, ordered by recency of access as is the cache
+type keyStats struct {
+	lock  sync.Mutex
+	links *list.List
+	index map[interface{}]*list.Element
+}
+
+type keyStat struct {
+	token interface{}
+	stats KeyStats
+}
+
+/* Utilities */
+
+// record accounts for a lookup of an already-mapped key, discarding the statistics of the least recently
+// accessed keys in excess of the cache's capacity
+func (ks *keyStats) record(lc *LRUCache, key interface{}, hit bool) {
+	token, now := lc.keys.token(key), lc.clock.Now()
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	e, ok := ks.index[token]
+	if ok {
+		ks.links.MoveToFront(e)
+	} else {
+		e = ks.links.PushFront(&keyStat{token: token})
+		ks.index[token] = e
+	}
+
+	ks.update(e.Value.(*keyStat), hit, now)
+
+	for ks.links.Len() > lc.Capacity() {
+		e := ks.links.Back()
+		ks.links.Remove(e)
+		delete(ks.index, e.Value.(*keyStat).token)
+	}
+}
+
+func (ks *keyStats) update(s *keyStat, hit bool, now time.Time) {
+	if hit {
+		s.stats.Hits++
+	} else {
+		s.stats.Misses++
+	}
+	s.stats.LastAccess = now
+}
+
+func (ks *keyStats) get(token interface{}) (KeyStats, bool) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	if e, ok := ks.index[token]; ok {
+		return e.Value.(*keyStat).stats, true
+	}
+
+	return KeyStats{}, false
+}
@@ -0,0 +1,50 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestKeyStats(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(1000, 0))
+	lru, err := New(2, nil, WithKeyStats(), WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Get("a")
+	lru.Put("a", 1)
+	lru.Get("a")
+	clock.Advance(time.Second)
+	lru.Get("a")
+
+	s, ok := lru.KeyStats("a")
+	if !ok || s.Hits != 2 || s.Misses != 1 || !s.LastAccess.Equal(clock.Now()) {
+		t.Fatalf("Key stats mismatch; Have %+v, Want 2 hits, 1 miss, last accessed at %v", s, clock.Now())
+	}
+
+	// The table is bounded by the cache's capacity, discarding the least recently accessed keys
+	lru.Get("b")
+	lru.Get("c")
+	if _, ok := lru.KeyStats("a"); ok {
+		t.Error("The statistics of the least recently accessed key should be discarded")
+	}
+
+	if s, ok := lru.KeyStats("c"); !ok || s.Misses != 1 {
+		t.Errorf("Key stats mismatch; Have %+v, Want 1 miss", s)
+	}
+}
+
+func TestKeyStatsDisabled(t *testing.T) {
+	lru, err := New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Get("a")
+	if _, ok := lru.KeyStats("a"); ok {
+		t.Error("Key stats should not be tracked absent WithKeyStats")
+	}
+}
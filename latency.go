@@ -0,0 +1,92 @@
+package tenure
+
+import (
+	"sync"
+	"time"
+)
+
+// latencyBounds are the bucket bounds of the cache's latency histograms
+var latencyBounds = []time.Duration{
+	time.Microsecond, 10 * time.Microsecond, 100 * time.Microsecond, time.Millisecond,
+	10 * time.Millisecond, 100 * time.Millisecond, time.Second, 10 * time.Second,
+}
+
+// LatencyStats reports the distributions of the latencies of the cache's operations; see WithLatencyHistograms
+type LatencyStats struct {
+	// Get is the distribution of latencies of Get, including any read through to the backing Store
+	Get Histogram
+	// Put is the distribution of latencies of Put, PutWithTTL, PutWith and TryPut, including any eviction
+	// callbacks dispatched and any write through to the backing Store
+	Put Histogram
+	// Del is the distribution of latencies of Del, including any write through to the backing Store
+	Del Histogram
+	// Load is the distribution of latencies of loads from the backing Store
+	Load Histogram
+}
+
+// WithLatencyHistograms configures the cache to record the latencies of its operations, reported by Stats
+// Latencies include the eviction callbacks and backing Store calls enacted by each operation, such that
+// regressions therein surface as slowdowns of the cache
+func WithLatencyHistograms() Option {
+	return func(lc *LRUCache) {
+		lc.latency = &latencies{hists: LatencyStats{
+			Get:  newHistogram(latencyBounds...),
+			Put:  newHistogram(latencyBounds...),
+			Del:  newHistogram(latencyBounds...),
+			Load: newHistogram(latencyBounds...),
+		}}
+	}
+}
+
+// latencyOp designates the histogram to which a latency is recorded
+type latencyOp int
+
+const (
+	latencyGet latencyOp = iota
+	latencyPut
+	latencyDel
+	latencyLoad
+)
+
+type latencies struct {
+	hists LatencyStats
+	lock  sync.Mutex
+}
+
+/* Utilities */
+
+// observe records the latency of an operation begun at `start`
+func (l *latencies) observe(op latencyOp, start time.Time) {
+	d := time.Since(start)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	switch op {
+	case latencyGet:
+		l.hists.Get.Observe(d)
+	case latencyPut:
+		l.hists.Put.Observe(d)
+	case latencyDel:
+		l.hists.Del.Observe(d)
+	case latencyLoad:
+		l.hists.Load.Observe(d)
+	}
+}
+
+// snapshot returns a copy of the histograms, or nil if latencies are not being recorded
+func (l *latencies) snapshot() *LatencyStats {
+	if l == nil {
+		return nil
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return &LatencyStats{
+		Get:  l.hists.Get.clone(),
+		Put:  l.hists.Put.clone(),
+		Del:  l.hists.Del.clone(),
+		Load: l.hists.Load.clone(),
+	}
+}
@@ -0,0 +1,47 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLatencyHistograms(t *testing.T) {
+	store := newMapStore()
+	store.data["a"] = 1
+
+	lru, err := New(8, nil, WithStore(store), WithLatencyHistograms())
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Get("a")
+	lru.Get("a")
+	lru.Put("b", 2)
+	lru.PutWithTTL("c", 3, 0, time.Minute)
+	lru.Del("b")
+
+	l := lru.Stats().Latency
+	if l == nil {
+		t.Fatal("Stats should report latencies")
+	}
+
+	for _, c := range []struct {
+		name string
+		have uint64
+		want uint64
+	}{
+		{"Get", l.Get.Count, 2},
+		{"Put", l.Put.Count, 2},
+		{"Del", l.Del.Count, 1},
+		{"Load", l.Load.Count, 1},
+	} {
+		if c.have != c.want {
+			t.Fatalf("%s latency count mismatch; Have %v, Want %v", c.name, c.have, c.want)
+		}
+	}
+
+	plain, _ := New(8, nil)
+	if plain.Put("a", 1); plain.Stats().Latency != nil {
+		t.Fatal("Stats should report no latencies absent recording")
+	}
+}
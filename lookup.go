@@ -0,0 +1,98 @@
+package tenure
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/internal/singleflight"
+)
+
+// LookupConfig configures a LookupCache
+type LookupConfig struct {
+	// PositiveTTL is the lifetime of successful results
+	PositiveTTL time.Duration
+	// NegativeTTL is the lifetime of failed results; zero disables caching of failures
+	NegativeTTL time.Duration
+	// Negative reports whether a failure is definitive, and so may be cached, e.g. a name that does not exist
+	// rather than a timeout; if nil, every failure is cached
+	Negative func(err error) bool
+}
+
+// LookupCache caches the results of a resolver-style lookup, e.g. a DNS query, including failures, so that
+// repeated lookups of names that do not exist are suppressed as are those of names that do
+// Successful and failed results bear separate TTLs, and concurrent lookups of a key are de-duplicated
+type LookupCache struct {
+	lc     *LRUCache
+	fn     func(key interface{}) (interface{}, error)
+	cfg    LookupConfig
+	flight singleflight.Group
+}
+
+type lookupResult struct {
+	value interface{}
+	err   error
+}
+
+// NewLookupCache initializes a new LookupCache of capacity `bufCap` over `fn`, configured by `opts`
+func NewLookupCache(fn func(key interface{}) (interface{}, error), bufCap int, cfg LookupConfig, opts ...Option) (*LookupCache, error) {
+	if cfg.PositiveTTL <= 0 {
+		return nil, errors.New("a lookup cache must be initialized with a positive TTL")
+	}
+
+	lc, err := New(bufCap, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LookupCache{lc: lc, fn: fn, cfg: cfg}, nil
+}
+
+// NewHostCache initializes a new LookupCache over net.DefaultResolver's LookupHost, keyed by host name and
+// yielding []string addresses; only failures reporting that a host was not found are cached
+func NewHostCache(bufCap int, positiveTTL, negativeTTL time.Duration, opts ...Option) (*LookupCache, error) {
+	lookup := func(key interface{}) (interface{}, error) {
+		return net.DefaultResolver.LookupHost(context.Background(), key.(string))
+	}
+
+	return NewLookupCache(lookup, bufCap, LookupConfig{
+		PositiveTTL: positiveTTL,
+		NegativeTTL: negativeTTL,
+		Negative: func(err error) bool {
+			var dnsErr *net.DNSError
+			return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+		},
+	}, opts...)
+}
+
+// Lookup returns the result of looking up `key`, from the cache if extant, else by invoking the lookup
+func (c *LookupCache) Lookup(key interface{}) (interface{}, error) {
+	if v, ok := c.lc.Get(key); ok {
+		r := v.(lookupResult)
+		return r.value, r.err
+	}
+
+	return c.flight.Do(key, func() (interface{}, error) {
+		v, err := c.fn(key)
+
+		switch {
+		case err == nil:
+			c.lc.PutWithTTL(key, lookupResult{value: v}, 0, c.cfg.PositiveTTL)
+		case c.cfg.NegativeTTL > 0 && (c.cfg.Negative == nil || c.cfg.Negative(err)):
+			c.lc.PutWithTTL(key, lookupResult{err: err}, 0, c.cfg.NegativeTTL)
+		}
+
+		return v, err
+	})
+}
+
+// Forget removes any cached result for `key`
+func (c *LookupCache) Forget(key interface{}) {
+	c.lc.Del(key)
+}
+
+// Cache returns the underlying cache, e.g. for inspection of its Stats
+func (c *LookupCache) Cache() *LRUCache {
+	return c.lc
+}
@@ -0,0 +1,74 @@
+package tenure
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+var errNoSuchHost = &net.DNSError{Err: "no such host", Name: "missing", IsNotFound: true}
+
+func TestLookupCacheTTLs(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	calls := map[interface{}]int{}
+	c, err := NewLookupCache(func(key interface{}) (interface{}, error) {
+		calls[key]++
+		switch key {
+		case "missing":
+			return nil, errNoSuchHost
+		case "flaky":
+			return nil, errors.New("timeout")
+		}
+		return []string{"10.0.0.1"}, nil
+	}, 8, LookupConfig{
+		PositiveTTL: time.Hour,
+		NegativeTTL: time.Minute,
+		Negative: func(err error) bool {
+			var dnsErr *net.DNSError
+			return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+		},
+	}, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new lookup cache; see %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if v, err := c.Lookup("host"); err != nil || v.([]string)[0] != "10.0.0.1" {
+			t.Fatalf("Lookup mismatch; Have (%v, %v)", v, err)
+		}
+
+		if _, err := c.Lookup("missing"); err != errNoSuchHost {
+			t.Fatalf("Failures should be returned verbatim; Have %v", err)
+		}
+
+		c.Lookup("flaky")
+	}
+
+	if calls["host"] != 1 || calls["missing"] != 1 || calls["flaky"] != 3 {
+		t.Fatalf("Only successes and definitive failures should be cached; Have %v", calls)
+	}
+
+	clock.Advance(2 * time.Minute)
+	c.Lookup("host")
+	c.Lookup("missing")
+
+	if calls["host"] != 1 || calls["missing"] != 2 {
+		t.Fatalf("Failures should expire per the negative TTL; Have %v", calls)
+	}
+
+	c.Forget("host")
+	c.Lookup("host")
+	if calls["host"] != 2 {
+		t.Fatalf("Forgotten results should be looked up afresh; Have %v", calls)
+	}
+}
+
+func TestLookupCacheRequiresPositiveTTL(t *testing.T) {
+	if _, err := NewLookupCache(func(key interface{}) (interface{}, error) { return key, nil }, 8, LookupConfig{}); err == nil {
+		t.Fatal("Expected an error absent a positive TTL")
+	}
+}
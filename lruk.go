@@ -0,0 +1,128 @@
+package tenure
+
+import "container/heap"
+
+// WithLRUK configures the cache to evict per the LRU-K algorithm; see EvictLRUK. The algorithm retains the times
+// of each entry's last `k` references and evicts the entry whose k-th most recent reference is the eldest. Entries
+// referenced fewer than `k` times are evicted first, least recently-used foremost, such that a burst of one-off
+// references cannot displace entries referenced repeatedly over time, as it would under LRU eviction
+// References are insertions, updates and hits, and are timed by a logical clock; `k` must be at least two, LRU-1
+// being LRU eviction itself. Hits and evictions cost time logarithmic in the size of the cache
+func WithLRUK(k int) Option {
+	return func(lc *LRUCache) {
+		if k < 2 {
+			return
+		}
+
+		lc.policy = EvictLRUK
+		lc.lruK = k
+	}
+}
+
+// historyHeap is a min-heap of the cache's entries under LRU-K eviction, ordered by their k-th most recent
+// reference, then by their most recent reference
+// Each entry records its position in the heap, offset by one so that the zero value denotes an entry absent
+type historyHeap []*pair
+
+func (h historyHeap) Len() int { return len(h) }
+
+func (h historyHeap) Less(i, j int) bool { return referencedBefore(h[i], h[j]) }
+
+func (h historyHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].rank, h[j].rank = i+1, j+1
+}
+
+func (h *historyHeap) Push(x interface{}) {
+	p := x.(*pair)
+	p.rank = len(*h) + 1
+	*h = append(*h, p)
+}
+
+func (h *historyHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	p := old[n-1]
+	old[n-1] = nil
+	p.rank = 0
+	*h = old[:n-1]
+	return p
+}
+
+/* Utilities */
+
+// reference records a reference to the entry, reconciling its position in the history heap
+// The write lock must be held
+func (lc *LRUCache) reference(p *pair) {
+	lc.ticks++
+
+	if len(p.history) < lc.lruK {
+		p.history = append(p.history, 0)
+	}
+	copy(p.history[1:], p.history)
+	p.history[0] = lc.ticks
+
+	if len(p.history) == lc.lruK {
+		p.kth = p.history[lc.lruK-1]
+	}
+
+	if p.rank == 0 {
+		heap.Push(&lc.histories, p)
+	} else {
+		heap.Fix(&lc.histories, p.rank-1)
+	}
+}
+
+// unrank removes the entry from the history heap, if present; the write lock must be held
+func (lc *LRUCache) unrank(p *pair) {
+	if p.rank != 0 {
+		heap.Remove(&lc.histories, p.rank-1)
+	}
+}
+
+// lrukVictim selects the entry bearing the eldest k-th most recent reference, other than the most recently-used,
+// that may be evicted without encroaching upon a reservation's minimum share. Ineligible entries are
+// passed over in order of the heap, by way of their children therein, such that only they are visited
+// Should every entry be ineligible, the least recently-used entry is selected regardless
+func (lc *LRUCache) lrukVictim() *pair {
+	if len(lc.histories) == 0 {
+		return lc.links.Back()
+	}
+
+	front := lc.links.Front()
+
+	frontier := []*pair{lc.histories[0]}
+	for len(frontier) > 0 {
+		least := 0
+		for i := range frontier {
+			if referencedBefore(frontier[i], frontier[least]) {
+				least = i
+			}
+		}
+
+		kv := frontier[least]
+		frontier[least] = frontier[len(frontier)-1]
+		frontier = frontier[:len(frontier)-1]
+
+		if r := kv.class; kv != front && (r == nil || r.count > r.slots) {
+			return kv
+		}
+
+		for _, i := range []int{2*kv.rank - 1, 2 * kv.rank} {
+			if i < len(lc.histories) {
+				frontier = append(frontier, lc.histories[i])
+			}
+		}
+	}
+
+	return lc.links.Back()
+}
+
+// referencedBefore reports whether `a` is due eviction ahead of `b` under LRU-K eviction
+func referencedBefore(a, b *pair) bool {
+	if a.kth != b.kth {
+		return a.kth < b.kth
+	}
+
+	return a.history[0] < b.history[0]
+}
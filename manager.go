@@ -0,0 +1,213 @@
+package tenure
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Manager is a registry of named caches sharing a single capacity budget
+// The budget counts entries, not bytes: it is divided among the registered caches as their capacities, and a
+// governor may be started to dynamically reallocate capacity between them so as to maximize the overall hit ratio.
+// The weight of entries is not governed; a cache bounded by weight must be so configured itself, as by
+// WithMemoryBudget
+type Manager struct {
+	lock    sync.Mutex
+	entries int
+	caches  map[string]*managedCache
+	clock   Clock
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+type managedCache struct {
+	cache     *LRUCache
+	ghostHits uint64
+}
+
+// ManagerOption configures optional behavior of a Manager at construction time
+type ManagerOption func(*Manager)
+
+// WithManagerClock configures the governor to pace itself by `c` rather than the system clock; see Govern
+func WithManagerClock(c Clock) ManagerOption {
+	return func(m *Manager) {
+		if c != nil {
+			m.clock = c
+		}
+	}
+}
+
+// NewManager initializes a new Manager governing a total capacity budget of `entries` entries
+func NewManager(entries int, opts ...ManagerOption) *Manager {
+	m := &Manager{entries: entries, caches: make(map[string]*managedCache), clock: systemClock{}}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Register adds a cache to the Manager under the given name, dividing the budget evenly among all registered
+// caches. Registered caches retain their recently evicted keys so as to estimate the benefit of more capacity
+func (m *Manager) Register(name string, lc *LRUCache) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.caches[name]; ok {
+		return errors.New("a cache is already registered under the name " + name)
+	}
+
+	if len(m.caches)+1 > m.entries {
+		return errors.New("the capacity budget cannot accommodate another cache")
+	}
+
+	m.caches[name] = &managedCache{cache: lc, ghostHits: lc.Stats().GhostHits}
+	m.redistribute()
+
+	return nil
+}
+
+// Unregister removes the named cache from the Manager, dividing the budget evenly among the remaining caches
+func (m *Manager) Unregister(name string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.caches[name]; ok {
+		delete(m.caches, name)
+		m.redistribute()
+	}
+}
+
+// Cache returns the cache registered under the given name
+func (m *Manager) Cache(name string) (*LRUCache, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mc, ok := m.caches[name]
+	if !ok {
+		return nil, false
+	}
+
+	return mc.cache, true
+}
+
+// Names returns the names of every registered cache, in lexical order
+func (m *Manager) Names() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.names()
+}
+
+// Rebalance enacts a single step of the governor: `step` entries of capacity are moved from the cache
+// whose capacity is least valuable to the cache that would benefit most from more, as measured by each
+// cache's misses on recently evicted keys per entry of capacity since the previous step
+// Returns the number of entries of capacity moved
+func (m *Manager) Rebalance(step int) (moved int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if step <= 0 || len(m.caches) < 2 {
+		return 0
+	}
+
+	var donor, recipient *managedCache
+	var minGain, maxGain float64
+
+	for _, name := range m.names() {
+		mc := m.caches[name]
+		s := mc.cache.Stats()
+
+		gain := float64(s.GhostHits-mc.ghostHits) / float64(s.Capacity)
+		mc.ghostHits = s.GhostHits
+
+		if recipient == nil || gain > maxGain {
+			recipient, maxGain = mc, gain
+		}
+
+		if s.Capacity > step && (donor == nil || gain < minGain) {
+			donor, minGain = mc, gain
+		}
+	}
+
+	if donor == nil || donor == recipient || maxGain <= minGain {
+		return 0
+	}
+
+	m.resize(donor, donor.cache.Capacity()-step)
+	m.resize(recipient, recipient.cache.Capacity()+step)
+
+	return step
+}
+
+// Govern starts a background governor enacting Rebalance every `interval`, moving `step` entries at a time
+// Close halts the governor
+func (m *Manager) Govern(interval time.Duration, step int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.stop != nil {
+		return
+	}
+
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+
+	go func(stop, done chan struct{}) {
+		defer close(done)
+
+		for pause(m.clock, interval, stop) {
+			m.Rebalance(step)
+		}
+	}(m.stop, m.done)
+}
+
+// Close halts the governor, if started
+func (m *Manager) Close() {
+	m.lock.Lock()
+	stop, done := m.stop, m.done
+	m.stop, m.done = nil, nil
+	m.lock.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+/* Utilities */
+
+func (m *Manager) names() []string {
+	names := make([]string, 0, len(m.caches))
+	for name := range m.caches {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func (m *Manager) redistribute() {
+	if len(m.caches) == 0 {
+		return
+	}
+
+	share, rem := m.entries/len(m.caches), m.entries%len(m.caches)
+
+	for _, name := range m.names() {
+		c := share
+		if rem > 0 {
+			c++
+			rem--
+		}
+
+		m.resize(m.caches[name], c)
+	}
+}
+
+func (m *Manager) resize(mc *managedCache, capacity int) {
+	mc.cache.AdjustCapacity(capacity)
+	mc.cache.trackGhosts(capacity)
+}
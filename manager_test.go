@@ -0,0 +1,100 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestManagerDividesBudget(t *testing.T) {
+	m := NewManager(10)
+
+	for _, name := range []string{"a", "b", "c"} {
+		lru, err := New(100, nil)
+		if err != nil {
+			t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+		}
+
+		if err := m.Register(name, lru); err != nil {
+			t.Fatalf("Failed to register cache; see %v", err)
+		}
+	}
+
+	total := 0
+	for _, name := range m.Names() {
+		c, _ := m.Cache(name)
+		total += c.Capacity()
+	}
+
+	if total != 10 {
+		t.Fatalf("Budget mismatch; Have %v, Want %v", total, 10)
+	}
+
+	if err := m.Register("a", nil); err == nil {
+		t.Fatal("Expected an error when registering a duplicate name")
+	}
+}
+
+func TestGovernorReallocatesToUndersizedCache(t *testing.T) {
+	m := NewManager(20)
+
+	hot, _ := New(1, nil)
+	cold, _ := New(1, nil)
+	m.Register("hot", hot)
+	m.Register("cold", cold)
+
+	// The hot cache cycles through a working set larger than its share, missing on recently evicted keys
+	for round := 0; round < 3; round++ {
+		for i := 0; i < 15; i++ {
+			if _, ok := hot.Get(i); !ok {
+				hot.Put(i, i)
+			}
+		}
+	}
+
+	if moved := m.Rebalance(5); moved != 5 {
+		t.Fatalf("Expected the governor to move capacity; Have %v moved, Want %v", moved, 5)
+	}
+
+	if hot.Capacity() != 15 || cold.Capacity() != 5 {
+		t.Fatalf("Capacity mismatch; Have (hot=%v, cold=%v), Want (hot=15, cold=5)", hot.Capacity(), cold.Capacity())
+	}
+
+	if moved := m.Rebalance(5); moved != 0 {
+		t.Fatalf("Expected no movement absent new ghost hits; Have %v moved", moved)
+	}
+}
+
+func TestGovernorPacedByClock(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	m := NewManager(20, WithManagerClock(clock))
+	defer m.Close()
+
+	hot, _ := New(1, nil)
+	cold, _ := New(1, nil)
+	m.Register("hot", hot)
+	m.Register("cold", cold)
+
+	for i := 0; i < 15; i++ {
+		hot.Put(i, i)
+	}
+	for i := 0; i < 15; i++ {
+		hot.Get(i)
+	}
+
+	m.Govern(time.Minute, 5)
+
+	// The governor arms its next timer once its rebalance is done
+	clock.BlockUntil(1)
+	if hot.Capacity() != 10 {
+		t.Fatalf("The governor should await its interval; Have %v, Want %v", hot.Capacity(), 10)
+	}
+
+	clock.Advance(time.Minute)
+	clock.BlockUntil(1)
+
+	if hot.Capacity() != 15 {
+		t.Fatalf("The governor should rebalance once its interval elapses; Have %v, Want %v", hot.Capacity(), 15)
+	}
+}
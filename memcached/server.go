@@ -0,0 +1,318 @@
+// Package memcached serves a tenure LRUCache over the memcached text protocol, so that existing memcached
+// clients may address an embedded Go cache. The get, gets, set, delete, flush_all, stats, version and quit
+// commands are supported; storage commands honour the noreply option, and set honours expiration times
+// as memcached does: relative seconds up to 30 days, a Unix timestamp thereafter
+package memcached
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// maxValueSize bounds the size of stored values, as does memcached's default item size limit
+const maxValueSize = 1 << 20
+
+// maxRelativeExpiry is the greatest expiration time memcached interprets as relative to the present
+const maxRelativeExpiry = 30 * 24 * 60 * 60
+
+// Item is the stored form of a value set with non-zero client flags; values set without flags are stored as []byte
+type Item struct {
+	Flags uint32
+	Value []byte
+}
+
+// Server serves the memcached text protocol over an LRUCache
+type Server struct {
+	c *tenure.LRUCache
+
+	mu        sync.Mutex
+	listeners map[net.Listener]struct{}
+	conns     map[net.Conn]struct{}
+	closed    bool
+	wg        sync.WaitGroup
+}
+
+// ErrServerClosed is returned by Serve once the Server has been closed
+var ErrServerClosed = errors.New("memcached: server closed")
+
+// NewServer initializes a new Server over `c`
+func NewServer(c *tenure.LRUCache) *Server {
+	return &Server{c: c, listeners: make(map[net.Listener]struct{}), conns: make(map[net.Conn]struct{})}
+}
+
+// ListenAndServe listens on the TCP network address `addr` and serves connections thereon; see Serve
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(l)
+}
+
+// Serve accepts connections on `l`, serving each in its own goroutine, until the Server is closed
+// Serve always returns a non-nil error; ErrServerClosed once the Server has been closed
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		l.Close()
+		return ErrServerClosed
+	}
+	s.listeners[l] = struct{}{}
+	s.mu.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			delete(s.listeners, l)
+			s.mu.Unlock()
+
+			if closed {
+				return ErrServerClosed
+			}
+			return err
+		}
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return ErrServerClosed
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go s.serve(conn)
+	}
+}
+
+// Close closes every listener and connection, awaiting the return of every connection's handler
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	for l := range s.listeners {
+		l.Close()
+	}
+	for c := range s.conns {
+		c.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return nil
+}
+
+/* Utilities */
+
+func (s *Server) serve(conn net.Conn) {
+	defer func() {
+		conn.Close()
+
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+
+		s.wg.Done()
+	}()
+
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			w.WriteString("ERROR\r\n")
+			w.Flush()
+			continue
+		}
+
+		if fields[0] == "quit" {
+			return
+		}
+
+		if err := s.dispatch(fields, r, w); err != nil {
+			return
+		}
+
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// dispatch executes a single command; a returned error indicates the connection must be closed
+func (s *Server) dispatch(fields []string, r *bufio.Reader, w *bufio.Writer) error {
+	switch fields[0] {
+	case "get", "gets":
+		if len(fields) < 2 {
+			w.WriteString("ERROR\r\n")
+			return nil
+		}
+
+		for _, key := range fields[1:] {
+			v, ok := s.c.Get(key)
+			if !ok {
+				continue
+			}
+
+			flags, data := decode(v)
+			fmt.Fprintf(w, "VALUE %s %d %d", key, flags, len(data))
+			if fields[0] == "gets" {
+				w.WriteString(" 0")
+			}
+			w.WriteString("\r\n")
+			w.Write(data)
+			w.WriteString("\r\n")
+		}
+
+		w.WriteString("END\r\n")
+	case "set":
+		return s.set(fields, r, w)
+	case "delete":
+		if len(fields) < 2 {
+			w.WriteString("ERROR\r\n")
+			return nil
+		}
+
+		reply := "NOT_FOUND\r\n"
+		if s.c.Del(fields[1]) {
+			reply = "DELETED\r\n"
+		}
+		if !noreply(fields[2:]) {
+			w.WriteString(reply)
+		}
+	case "flush_all":
+		s.c.Drop()
+		if !noreply(fields[1:]) {
+			w.WriteString("OK\r\n")
+		}
+	case "stats":
+		st := s.c.Stats()
+
+		fmt.Fprintf(w, "STAT get_hits %d\r\n", st.Hits)
+		fmt.Fprintf(w, "STAT get_misses %d\r\n", st.Misses)
+		fmt.Fprintf(w, "STAT evictions %d\r\n", st.Evictions)
+		fmt.Fprintf(w, "STAT curr_items %d\r\n", st.ListLen)
+		fmt.Fprintf(w, "STAT limit_items %d\r\n", st.Capacity)
+		w.WriteString("END\r\n")
+	case "version":
+		w.WriteString("VERSION tenure\r\n")
+	default:
+		w.WriteString("ERROR\r\n")
+	}
+
+	return nil
+}
+
+// set executes `set <key> <flags> <exptime> <bytes> [noreply]`, reading the data block that follows
+func (s *Server) set(fields []string, r *bufio.Reader, w *bufio.Writer) error {
+	if len(fields) < 5 {
+		w.WriteString("ERROR\r\n")
+		return nil
+	}
+
+	flags, ferr := strconv.ParseUint(fields[2], 10, 32)
+	exptime, eerr := strconv.ParseInt(fields[3], 10, 64)
+	size, serr := strconv.Atoi(fields[4])
+	if ferr != nil || eerr != nil || serr != nil || size < 0 {
+		w.WriteString("CLIENT_ERROR bad command line format\r\n")
+		return nil
+	}
+
+	if size > maxValueSize {
+		w.WriteString("SERVER_ERROR object too large for cache\r\n")
+		return errors.New("value too large")
+	}
+
+	data := make([]byte, size+2)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return err
+	}
+
+	if string(data[size:]) != "\r\n" {
+		if data[size+1] != '\n' {
+			if _, err := r.ReadString('\n'); err != nil {
+				return err
+			}
+		}
+
+		w.WriteString("CLIENT_ERROR bad data chunk\r\n")
+		return nil
+	}
+	data = data[:size]
+
+	key := fields[1]
+
+	var v interface{} = data
+	if flags != 0 {
+		v = Item{Flags: uint32(flags), Value: data}
+	}
+
+	switch ttl := expiry(exptime); {
+	case ttl < 0:
+		s.c.Del(key)
+	case ttl == 0:
+		s.c.Put(key, v)
+	default:
+		s.c.PutWithTTL(key, v, 0, ttl)
+	}
+
+	if !noreply(fields[5:]) {
+		w.WriteString("STORED\r\n")
+	}
+
+	return nil
+}
+
+// expiry converts a memcached expiration time to a TTL; zero denotes none, and a negative TTL immediate expiry
+func expiry(exptime int64) time.Duration {
+	switch {
+	case exptime == 0:
+		return 0
+	case exptime < 0:
+		return -1
+	case exptime <= maxRelativeExpiry:
+		return time.Duration(exptime) * time.Second
+	}
+
+	if ttl := time.Until(time.Unix(exptime, 0)); ttl > 0 {
+		return ttl
+	}
+
+	return -1
+}
+
+func decode(v interface{}) (flags uint32, data []byte) {
+	switch v := v.(type) {
+	case Item:
+		return v.Flags, v.Value
+	case []byte:
+		return 0, v
+	case string:
+		return 0, []byte(v)
+	default:
+		return 0, []byte(fmt.Sprint(v))
+	}
+}
+
+func noreply(args []string) bool {
+	return len(args) > 0 && args[len(args)-1] == "noreply"
+}
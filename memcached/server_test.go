@@ -0,0 +1,97 @@
+package memcached
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+func dial(t *testing.T, lru *tenure.LRUCache) (net.Conn, *bufio.Reader, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen; see %v", err)
+	}
+
+	s := NewServer(lru)
+	go s.Serve(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("Failed to dial; see %v", err)
+	}
+
+	return conn, bufio.NewReader(conn), func() {
+		conn.Close()
+		s.Close()
+	}
+}
+
+func roundTrip(t *testing.T, conn net.Conn, r *bufio.Reader, req string, lines int) string {
+	if _, err := conn.Write([]byte(req)); err != nil {
+		t.Fatalf("Write failure; see %v", err)
+	}
+
+	var b strings.Builder
+	for i := 0; i < lines; i++ {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("Read failure; see %v", err)
+		}
+		b.WriteString(line)
+	}
+
+	return b.String()
+}
+
+func TestMemcachedProtocol(t *testing.T) {
+	lru, err := tenure.New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	conn, r, done := dial(t, lru)
+	defer done()
+
+	tests := []struct {
+		req   string
+		lines int
+		want  string
+	}{
+		{"set a 0 0 5\r\nhello\r\n", 1, "STORED\r\n"},
+		{"set b 42 0 3\r\nbye\r\n", 1, "STORED\r\n"},
+		{"get a b c\r\n", 5, "VALUE a 0 5\r\nhello\r\nVALUE b 42 3\r\nbye\r\nEND\r\n"},
+		{"gets a\r\n", 3, "VALUE a 0 5 0\r\nhello\r\nEND\r\n"},
+		{"set c 0 0 1 noreply\r\nx\r\nget b\r\n", 1, "END\r\n"},
+		{"delete a\r\n", 1, "DELETED\r\n"},
+		{"delete a\r\n", 1, "NOT_FOUND\r\n"},
+		{"set d 0 -1 1\r\nx\r\nget d\r\n", 2, "STORED\r\nEND\r\n"},
+		{"set e 0 0 1\r\nxyz\r\n", 1, "CLIENT_ERROR bad data chunk\r\n"},
+		{"bogus\r\n", 1, "ERROR\r\n"},
+		{"version\r\n", 1, "VERSION tenure\r\n"},
+		{"flush_all\r\nget c\r\n", 2, "OK\r\nEND\r\n"},
+	}
+
+	for _, tt := range tests {
+		if have := roundTrip(t, conn, r, tt.req, tt.lines); have != tt.want {
+			t.Fatalf("Response mismatch for %q; Have %q, Want %q", tt.req, have, tt.want)
+		}
+	}
+
+	stats := roundTrip(t, conn, r, "stats\r\n", 6)
+	if !strings.Contains(stats, "STAT evictions 1\r\n") || !strings.HasSuffix(stats, "END\r\n") {
+		t.Fatalf("Stats mismatch; Have %q", stats)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	if d := expiry(60); d.Seconds() != 60 {
+		t.Fatalf("Relative expiry mismatch; Have %v", d)
+	}
+
+	if d := expiry(maxRelativeExpiry + 1); d != -1 {
+		t.Fatalf("Absolute expiry in the past should expire immediately; Have %v", d)
+	}
+}
@@ -0,0 +1,26 @@
+package tenure
+
+import "github.com/MatthewZito/tenure-go/internal/singleflight"
+
+// Memoize returns a memoized version of `fn`, caching its results in `lc`, which should be dedicated to it
+// The cache remains the caller's to configure and to Close. Concurrent calls for a key not yet cached are
+// de-duplicated, such that `fn` is invoked once on behalf of every caller. Errors are returned to every
+// de-duplicated caller, but never cached. Keys must be comparable, as for any key of the cache
+func Memoize(lc *LRUCache, fn func(key interface{}) (interface{}, error)) func(key interface{}) (interface{}, error) {
+	var flight singleflight.Group
+
+	return func(key interface{}) (interface{}, error) {
+		if v, ok := lc.Get(key); ok {
+			return v, nil
+		}
+
+		return flight.Do(key, func() (interface{}, error) {
+			v, err := fn(key)
+			if err == nil {
+				lc.Put(key, v)
+			}
+
+			return v, err
+		})
+	}
+}
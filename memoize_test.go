@@ -0,0 +1,88 @@
+package tenure
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoize(t *testing.T) {
+	var calls int32
+
+	lru, _ := New(2, nil)
+	square := Memoize(lru, func(key interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		if key.(int) < 0 {
+			return nil, errors.New("negative")
+		}
+		return key.(int) * key.(int), nil
+	})
+
+	for i := 0; i < 3; i++ {
+		if v, err := square(3); err != nil || v != 9 {
+			t.Fatalf("Result mismatch; Have (%v, %v), Want (9, nil)", v, err)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("Results should be memoized; Have %v calls, Want %v", calls, 1)
+	}
+
+	square(-1)
+	square(-1)
+	if calls != 3 {
+		t.Fatalf("Errors should not be memoized; Have %v calls, Want %v", calls, 3)
+	}
+
+	square(4)
+	square(5)
+	square(3)
+	if calls != 6 {
+		t.Fatalf("Memoized results should be evicted per capacity; Have %v calls, Want %v", calls, 6)
+	}
+}
+
+func TestMemoizeDeduplicatesConcurrentCalls(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+
+	lru, _ := New(4, nil)
+	slow := Memoize(lru, func(key interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return key, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, _ := slow("k"); v != "k" {
+				t.Errorf("Result mismatch; Have %v, Want %v", v, "k")
+			}
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Concurrent calls should be de-duplicated; Have %v calls, Want %v", calls, 1)
+	}
+}
+
+func TestMemoizeCallerOwnedCache(t *testing.T) {
+	lru, _ := New(4, nil, WithJanitor(JanitorConfig{}))
+	defer lru.Close()
+
+	id := Memoize(lru, func(key interface{}) (interface{}, error) { return key, nil })
+	id("a")
+
+	if !lru.Has("a") {
+		t.Fatal("Results should be memoized in the given cache")
+	}
+}
@@ -0,0 +1,206 @@
+package tenure
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Weigher declares the weight, in bytes, of a key / value pair
+type Weigher func(key, value interface{}) int64
+
+// MemoryBudgetConfig configures a memory budget; see WithMemoryBudget
+type MemoryBudgetConfig struct {
+	// Budget is the number of bytes the cache's entries may occupy
+	Budget int64
+	// Weigher declares the weight of each entry upon insertion
+	Weigher Weigher
+	// Measure measures the actual weight of an entry; it defaults to the size of the entry's JSON encoding
+	// Measurement may be costly, and so is enacted only upon samples of entries
+	Measure Weigher
+	// SampleInterval is the interval between samples; it defaults to one minute
+	SampleInterval time.Duration
+	// SampleSize is the number of entries measured per sample; it defaults to 32
+	SampleSize int
+}
+
+// WithMemoryBudget bounds the cache by the weight of its entries, in addition to its capacity
+// Each entry is weighed by the configured Weigher, or the cache's Sizer, upon insertion, and least recently-used
+// entries are evicted while the estimated weight of all entries exceeds the budget. As declared weights are seldom
+// accurate, the cache periodically measures a sample of its entries and scales declared weights by the
+// ratio of measured to declared weight, smoothed across samples, so that chronic under- or over-estimation
+// is corrected. Close must be invoked to halt sampling
+func WithMemoryBudget(cfg MemoryBudgetConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.Budget <= 0 {
+			return
+		}
+
+		if cfg.Measure == nil {
+			cfg.Measure = encodedSize
+		}
+
+		if cfg.SampleInterval <= 0 {
+			cfg.SampleInterval = time.Minute
+		}
+
+		if cfg.SampleSize <= 0 {
+			cfg.SampleSize = 32
+		}
+
+		lc.budget = &memoryBudget{cfg: cfg, correction: 1}
+	}
+}
+
+// MemoryUsage reports the declared weight of the cache's entries, the weight estimated by correcting it per
+// sampled measurements, and the correction factor applied; all are zero absent a memory budget
+func (lc *LRUCache) MemoryUsage() (declared int64, estimated int64, correction float64) {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	if lc.budget == nil {
+		return 0, 0, 0
+	}
+
+	return lc.budget.declared, lc.budget.estimated(), lc.budget.correction
+}
+
+/* Utilities */
+
+// correctionSmoothing is the weight given to each new sample's ratio in the smoothed correction factor
+const correctionSmoothing = 0.25
+
+type memoryBudget struct {
+	cfg        MemoryBudgetConfig
+	declared   int64
+	correction float64
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+func (mb *memoryBudget) start(lc *LRUCache) {
+	mb.stop = make(chan struct{})
+	mb.done = make(chan struct{})
+
+	go mb.run(lc)
+}
+
+func (mb *memoryBudget) run(lc *LRUCache) {
+	defer close(mb.done)
+
+	for pause(lc.clock, mb.cfg.SampleInterval, mb.stop) {
+		lc.sampleWeights()
+	}
+}
+
+func (mb *memoryBudget) close() error {
+	select {
+	case <-mb.stop:
+	default:
+		close(mb.stop)
+		<-mb.done
+	}
+
+	return nil
+}
+
+func (mb *memoryBudget) estimated() int64 {
+	return int64(float64(mb.declared) * mb.correction)
+}
+
+// weigh returns the declared weight of a key / value pair per the Sizer, or else the memory budget's Weigher;
+// zero absent both
+func (lc *LRUCache) weigh(key, value interface{}) int64 {
+	switch {
+	case lc.sizer != nil:
+		return lc.sizer(key, value)
+	case lc.budget != nil:
+		return lc.budget.cfg.Weigher(key, value)
+	}
+
+	return 0
+}
+
+// reweigh sets the declared weight of `p`, accounting for the difference; the write lock must be held
+func (lc *LRUCache) reweigh(p *pair, weight int64) {
+	lc.costs += weight - p.weight
+	if lc.budget != nil {
+		lc.budget.declared += weight - p.weight
+	}
+
+	if st := lc.namespaceOfPair(p); st != nil {
+		st.cost += weight - p.weight
+	}
+
+	p.weight = weight
+}
+
+// shed evicts least recently-used entries, sparing the most recently-used, while the memory budget or maximum
+// cost is exceeded. Returns true if any entry was evicted; the write lock must be held
+func (lc *LRUCache) shed() (wasEvicted bool) {
+	for (lc.overBudget() || lc.overCost()) && lc.links.Len() > 1 {
+		kv := lc.victim()
+		if kv == nil || kv == lc.links.Front() {
+			break
+		}
+
+		lc.evict(kv)
+		wasEvicted = true
+	}
+
+	return
+}
+
+// overBudget reports whether the estimated weight of the cache's entries exceeds its memory budget
+// The write lock must be held
+func (lc *LRUCache) overBudget() bool {
+	return lc.budget != nil && lc.budget.estimated() > lc.budget.cfg.Budget
+}
+
+// sampleWeights measures a sample of entries, updating the correction factor, and enforces the budget thereby
+func (lc *LRUCache) sampleWeights() {
+	mb := lc.budget
+
+	type sample struct {
+		key, value interface{}
+		declared   int64
+	}
+
+	lc.lock.RLock()
+	samples := make([]sample, 0, mb.cfg.SampleSize)
+	for _, kv := range lc.cache {
+		if len(samples) == mb.cfg.SampleSize {
+			break
+		}
+
+		samples = append(samples, sample{kv.key, kv.value, kv.weight})
+	}
+	lc.lock.RUnlock()
+
+	var declared, measured int64
+	for _, s := range samples {
+		declared += s.declared
+		measured += mb.cfg.Measure(s.key, s.value)
+	}
+
+	if declared <= 0 || measured <= 0 {
+		return
+	}
+
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	ratio := float64(measured) / float64(declared)
+	mb.correction += correctionSmoothing * (ratio - mb.correction)
+
+	lc.shed()
+}
+
+// encodedSize measures a key / value pair as the size of its JSON encoding, or zero if it cannot be encoded
+func encodedSize(key, value interface{}) int64 {
+	b, err := json.Marshal([2]interface{}{key, value})
+	if err != nil {
+		return 0
+	}
+
+	return int64(len(b))
+}
@@ -0,0 +1,82 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryBudgetEvictsByWeight(t *testing.T) {
+	lru, err := New(100, nil, WithMemoryBudget(MemoryBudgetConfig{
+		Budget:         10,
+		Weigher:        func(k, v interface{}) int64 { return int64(len(v.(string))) },
+		SampleInterval: time.Hour,
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	lru.Put("a", "1234")
+	lru.Put("b", "1234")
+
+	if evicted := lru.Put("c", "1234"); !evicted || lru.Has("a") {
+		t.Fatal("Exceeding the budget should evict the least recently-used entry")
+	}
+
+	if declared, _, _ := lru.MemoryUsage(); declared != 8 {
+		t.Fatalf("Declared weight mismatch; Have %v, Want %v", declared, 8)
+	}
+
+	if evicted := lru.Put("c", "123456789"); !evicted || lru.Size() != 1 {
+		t.Fatalf("Growing an entry beyond the budget should evict others; Have size %v", lru.Size())
+	}
+
+	lru.Del("c")
+	if declared, _, _ := lru.MemoryUsage(); declared != 0 {
+		t.Fatalf("Declared weight mismatch; Have %v, Want %v", declared, 0)
+	}
+}
+
+func TestMemoryBudgetCorrectsDeclaredWeights(t *testing.T) {
+	lru, err := New(100, nil, WithMemoryBudget(MemoryBudgetConfig{
+		Budget:         100,
+		Weigher:        func(k, v interface{}) int64 { return 1 },
+		Measure:        func(k, v interface{}) int64 { return 4 },
+		SampleInterval: time.Hour,
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	for i := 0; i < 50; i++ {
+		lru.Put(i, i)
+	}
+
+	for i := 0; i < 20; i++ {
+		lru.sampleWeights()
+	}
+
+	declared, estimated, correction := lru.MemoryUsage()
+	if correction < 3.9 || correction > 4 {
+		t.Fatalf("Correction should converge upon the measured ratio; Have %v", correction)
+	}
+
+	if estimated > 100 || declared != int64(lru.Size()) {
+		t.Fatalf("Sampling should enforce the corrected budget; Have (declared %v, estimated %v)", declared, estimated)
+	}
+
+	if lru.Size() >= 50 || !lru.Has(49) {
+		t.Fatalf("The least recently-used entries should have been evicted; Have size %v", lru.Size())
+	}
+}
+
+func TestEncodedSize(t *testing.T) {
+	if n := encodedSize("k", "value"); n != int64(len(`["k","value"]`)) {
+		t.Fatalf("Encoded size mismatch; Have %v", n)
+	}
+
+	if n := encodedSize("k", make(chan int)); n != 0 {
+		t.Fatalf("Unencodable entries should measure zero; Have %v", n)
+	}
+}
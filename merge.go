@@ -0,0 +1,49 @@
+package tenure
+
+// MergeStrategy designates how Merge resolves keys extant in both caches; it defaults to MergeNewerWins
+type MergeStrategy int
+
+const (
+	// MergeNewerWins replaces an extant entry with the other cache's, where the latter was created more recently
+	MergeNewerWins MergeStrategy = iota + 1
+	// MergeSkipExisting retains extant entries, importing only keys absent from the cache
+	MergeSkipExisting
+)
+
+// Merge imports the entries of `other` into the cache, with their TTLs, provenance and priorities, resolving
+// keys extant in both per `strategy`, e.g. to consolidate per-goroutine caches into a shared one at checkpoint
+// time. Entries are imported from least to most recently-used in `other`, such that they assume its order of
+// recency atop the cache's own entries, enacting the eviction policy where they exceed the cache's capacity
+// Values are imported as Get would return them; keys are mapped anew, such that both caches must share any key
+// hashing secret. As with Receive, imported entries are neither written to a backing Store nor published
+// Returns the number of entries imported
+func (lc *LRUCache) Merge(other *LRUCache, strategy MergeStrategy) (merged int) {
+	if other == nil || other == lc {
+		return 0
+	}
+
+	if strategy != MergeSkipExisting {
+		strategy = MergeNewerWins
+	}
+
+	for _, e := range other.cloneEntries() {
+		value, err := other.decode(e.value)
+		if err != nil {
+			continue
+		}
+
+		if !e.spec.negative {
+			if value, err = lc.admit(value); err != nil {
+				continue
+			}
+		}
+
+		var outcome insertOutcome
+		e.spec.merge, e.spec.outcome = strategy, &outcome
+		if lc.insert(e.key, value, e.spec); outcome.written {
+			merged++
+		}
+	}
+
+	return merged
+}
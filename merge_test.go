@@ -0,0 +1,61 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestMerge(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	shared, _ := New(10, nil, WithClock(clock))
+	local, _ := New(10, nil, WithClock(clock))
+
+	local.Put("a", "stale")
+	shared.Put("b", "shared")
+	clock.Advance(time.Second)
+	shared.Put("a", "fresh")
+	local.Put("b", "local")
+	local.Put("c", "local")
+	local.Get("a")
+
+	if n := shared.Merge(local, MergeNewerWins); n != 2 {
+		t.Errorf("Merged count mismatch; Have %v, Want %v", n, 2)
+	}
+
+	for k, want := range map[string]string{"a": "fresh", "b": "local", "c": "local"} {
+		if v, _ := shared.Get(k); v != want {
+			t.Errorf("Value of %v mismatch; Have %v, Want %v", k, v, want)
+		}
+	}
+}
+
+func TestMergeSkipExisting(t *testing.T) {
+	shared, _ := New(3, nil)
+	local, _ := New(3, nil)
+
+	shared.Put("a", 1)
+	local.Put("a", 2)
+	local.Put("b", 3)
+	local.Put("c", 4)
+
+	if n := shared.Merge(local, MergeSkipExisting); n != 2 {
+		t.Errorf("Merged count mismatch; Have %v, Want %v", n, 2)
+	}
+
+	if v, _ := shared.Get("a"); v != 1 {
+		t.Errorf("Extant entries should be retained; Have %v, Want %v", v, 1)
+	}
+
+	// Imported entries assume the other cache's recency atop the cache's own
+	shared.Put("d", 5)
+	if shared.Has("b") || !shared.Has("c") {
+		t.Errorf("Imported entries should follow the other cache's order of recency; Have %v", shared.Keys())
+	}
+
+	if shared.Merge(shared, MergeNewerWins) != 0 {
+		t.Error("Merging a cache into itself should import nothing")
+	}
+}
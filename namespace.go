@@ -0,0 +1,325 @@
+package tenure
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// NamespaceQuota bounds the share of the cache held by a single namespace; see WithNamespaceQuota
+type NamespaceQuota struct {
+	// MaxEntries is the maximum number of entries within the namespace; zero imposes no maximum
+	MaxEntries int
+	// MaxCost is the maximum total cost of the entries within the namespace; zero imposes no maximum
+	MaxCost int64
+}
+
+// NamespaceStats reports usage statistics of a namespace
+type NamespaceStats struct {
+	// Hits and Misses count lookups via the namespace's view
+	Hits   uint64
+	Misses uint64
+	// Evictions is the number of entries within the namespace removed by the eviction policy or its quota
+	Evictions uint64
+	// Size is the number of entries extant within the namespace
+	Size int
+	// Cost is the total cost of the entries within the namespace; see WithSizer
+	Cost int64
+	// Quota is the namespace's quota, if any; see WithNamespaceQuota
+	Quota NamespaceQuota
+}
+
+// namespace is a view over the entries of an LRUCache within a single namespace; see (*LRUCache).Namespace
+type namespace struct {
+	lc   *LRUCache
+	name string
+}
+
+// namespaceState accounts for the entries of a namespace, or of a partition of a PartitionedCache, these being
+// one and the same. It is created upon the insertion of the namespace's first entry and discarded, statistics
+// and all, once the namespace holds none, unless it bears a quota, such that lookups within namespaces absent
+// from the cache retain nothing; views therefore look it up anew by name
+// Hits and misses are updated atomically, and all else under the write lock
+type namespaceState struct {
+	hits      uint64
+	misses    uint64
+	evictions uint64
+	entries   int
+	cost      int64
+	quota     NamespaceQuota
+}
+
+// WithNamespaceQuota bounds the namespace `name` per `q`, such that a single tenant of a shared cache cannot
+// monopolize it: once an insertion within the namespace exceeds its quota, the namespace's own least
+// recently-used entries are evicted until it is within its quota anew. The inserted entry is never evicted
+// thereby, though it may be refused per WithMaxEntryCost. Namespaces bear no quota absent this option
+func WithNamespaceQuota(name string, q NamespaceQuota) Option {
+	return func(lc *LRUCache) {
+		lc.namespace(name).quota = q
+	}
+}
+
+var _ LRUController = namespace{}
+
+// Namespace returns a view over the cache wherein every key is scoped to the namespace `name`, such that a key
+// stored via one namespace is never visible from another, nor from the cache itself but as a PartitionKey whose
+// Partition is `name`; eviction callbacks, events and the cache's own Keys surface entries of a namespace so
+// Namespaces share the cache's capacity and eviction policy; Drop purges the namespace alone, via DropNamespace,
+// while AdjustCapacity adjusts the capacity of the cache as a whole
+// Namespaces require stored keys to remain recoverable, and so are unsupported by a cache configured
+// WithKeyHashing, whose views would scope keys but could neither list, count nor drop them; an error is returned
+func (lc *LRUCache) Namespace(name string) (LRUController, error) {
+	if lc.keySecret != nil {
+		return nil, errors.New("a namespace cannot be created within a cache configured with key hashing")
+	}
+
+	return namespace{lc: lc, name: name}, nil
+}
+
+// NamespaceStats returns a snapshot of the usage statistics of the namespace `name`
+// A namespace holding no entries, and bearing no quota, reports none; see WithNamespaceQuota
+func (lc *LRUCache) NamespaceStats(name string) NamespaceStats {
+	return lc.namespaceStats(name)
+}
+
+// DropNamespace atomically removes every entry within the namespace `name`, returning the number removed
+// As with Drop, removed entries are reported as evictions, invoking the eviction callback
+func (lc *LRUCache) DropNamespace(name string) (numDropped int) {
+	lc.debug.reentry(lc, "DropNamespace", nil)
+
+	return lc.purgeNamespace(name, true)
+}
+
+func (ns namespace) Get(key interface{}) (value interface{}, ok bool) {
+	value, ok = ns.lc.Get(PartitionKey{ns.name, key})
+	ns.lc.lookedUp(ns.name, ok)
+
+	return
+}
+
+func (ns namespace) Put(key, value interface{}) (wasEvicted bool) {
+	return ns.lc.Put(PartitionKey{ns.name, key}, value)
+}
+
+func (ns namespace) Del(key interface{}) (wasDeleted bool) {
+	return ns.lc.Del(PartitionKey{ns.name, key})
+}
+
+// Keys returns the keys extant within the namespace, in the order of the cache's Keys, without their namespace
+func (ns namespace) Keys() []interface{} {
+	ns.lc.lock.RLock()
+	defer ns.lc.lock.RUnlock()
+
+	var keys []interface{}
+	if st, ok := ns.lc.namespaces[ns.name]; ok {
+		keys = make([]interface{}, 0, st.entries)
+	}
+	for kv := ns.lc.links.Back(); kv != nil; kv = kv.Prev() {
+		if pk, ok := partitionKey(kv.key); ok && pk.Partition == ns.name {
+			keys = append(keys, pk.Key)
+		}
+	}
+
+	return keys
+}
+
+func (ns namespace) Peek(key interface{}) (value interface{}) {
+	return ns.lc.Peek(PartitionKey{ns.name, key})
+}
+
+func (ns namespace) Has(key interface{}) (ok bool) {
+	return ns.lc.Has(PartitionKey{ns.name, key})
+}
+
+func (ns namespace) Drop() {
+	ns.lc.DropNamespace(ns.name)
+}
+
+// Purge drops all items, as does Drop
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (ns namespace) Purge() {
+	ns.Drop()
+}
+
+// Size returns the number of entries extant within the namespace
+func (ns namespace) Size() int {
+	return ns.lc.namespaceSize(ns.name)
+}
+
+func (ns namespace) AdjustCapacity(bufCap int) (numEvicted int) {
+	return ns.lc.AdjustCapacity(bufCap)
+}
+
+/* Utilities */
+
+// partitionKey returns the stored key as a PartitionKey, if it is one
+func partitionKey(key interface{}) (PartitionKey, bool) {
+	if ref, ok := key.(*KeyRef); ok {
+		key = ref.Key
+	}
+
+	pk, ok := key.(PartitionKey)
+	return pk, ok
+}
+
+// namespaceOf returns the namespace, or partition, of the stored key, if it bears one
+func namespaceOf(key interface{}) (interface{}, bool) {
+	pk, ok := partitionKey(key)
+	return pk.Partition, ok
+}
+
+// namespace returns the state of the namespace, or partition, `name`, creating it if need be; the write lock
+// must be held
+func (lc *LRUCache) namespace(name interface{}) *namespaceState {
+	st, ok := lc.namespaces[name]
+	if !ok {
+		if lc.namespaces == nil {
+			lc.namespaces = make(map[interface{}]*namespaceState)
+		}
+
+		st = &namespaceState{}
+		lc.namespaces[name] = st
+	}
+
+	return st
+}
+
+// namespaceOfPair returns the state of the namespace of the entry, or nil if it bears none, or if the namespace
+// holds no entries; the lock must be held
+func (lc *LRUCache) namespaceOfPair(p *pair) *namespaceState {
+	name, ok := namespaceOf(p.key)
+	if !ok {
+		return nil
+	}
+
+	return lc.namespaces[name]
+}
+
+// namespaceStats returns a snapshot of the usage statistics of the namespace, or partition, `name`
+func (lc *LRUCache) namespaceStats(name interface{}) NamespaceStats {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	st, ok := lc.namespaces[name]
+	if !ok {
+		return NamespaceStats{}
+	}
+
+	return NamespaceStats{
+		Hits:      atomic.LoadUint64(&st.hits),
+		Misses:    atomic.LoadUint64(&st.misses),
+		Evictions: st.evictions,
+		Size:      st.entries,
+		Cost:      st.cost,
+		Quota:     st.quota,
+	}
+}
+
+// lookedUp counts a lookup within the namespace, or partition, `name` as a hit or a miss, unless the namespace
+// holds no entries, such that lookups within absent namespaces retain no state
+func (lc *LRUCache) lookedUp(name interface{}, hit bool) {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	st, ok := lc.namespaces[name]
+	switch {
+	case !ok:
+	case hit:
+		atomic.AddUint64(&st.hits, 1)
+	default:
+		atomic.AddUint64(&st.misses, 1)
+	}
+}
+
+// purgeNamespace atomically removes every entry within the namespace, or partition, `name`, returning the
+// number removed. Removed entries are reported as evictions, invoking the eviction callback, where `evict`;
+// else, as deletions
+func (lc *LRUCache) purgeNamespace(name interface{}, evict bool) (numPurged int) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	for kv := lc.links.Back(); kv != nil; {
+		prev := kv.Prev()
+
+		if ns, ok := namespaceOf(kv.key); ok && ns == name {
+			lc.purgeLRUItem(kv)
+			if evict {
+				lc.emit(EventEvict, kv)
+				lc.tryEvict(kv)
+			} else {
+				lc.emit(EventDelete, kv)
+			}
+			lc.release(kv)
+			numPurged++
+		}
+
+		kv = prev
+	}
+
+	lc.maybeCompact()
+	return
+}
+
+// namespaceSize returns the number of entries within the namespace, or partition, `name`
+func (lc *LRUCache) namespaceSize(name interface{}) int {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	if st, ok := lc.namespaces[name]; ok {
+		return st.entries
+	}
+
+	return 0
+}
+
+// tally accounts for the insertion, or removal where `delta` is negative, of the entry within its namespace,
+// creating the namespace's state upon its first entry and discarding it upon its last, unless it bears a quota
+// Costs are accounted for as entries are reweighed; the write lock must be held
+func (lc *LRUCache) tally(p *pair, delta int) {
+	name, ok := namespaceOf(p.key)
+	if !ok {
+		return
+	}
+
+	st, ok := lc.namespaces[name]
+	if !ok {
+		if delta < 0 {
+			return
+		}
+		st = lc.namespace(name)
+	}
+
+	st.entries += delta
+	if st.entries <= 0 && st.quota == (NamespaceQuota{}) {
+		delete(lc.namespaces, name)
+	}
+}
+
+// enforceQuota evicts the least recently-used entries of the namespace of `p`, other than `p` itself, while the
+// namespace exceeds its quota. Returns true if any entry was evicted; the write lock must be held
+func (lc *LRUCache) enforceQuota(p *pair) (wasEvicted bool) {
+	st := lc.namespaceOfPair(p)
+	if st == nil || st.quota == (NamespaceQuota{}) {
+		return false
+	}
+
+	name, _ := namespaceOf(p.key)
+	for kv := lc.links.Back(); kv != nil && st.over(); {
+		prev := kv.Prev()
+
+		if ns, ok := namespaceOf(kv.key); ok && ns == name && kv != p {
+			lc.evict(kv)
+			wasEvicted = true
+		}
+
+		kv = prev
+	}
+
+	return
+}
+
+// over reports whether the namespace exceeds its quota
+func (st *namespaceState) over() bool {
+	q := st.quota
+	return q.MaxEntries > 0 && st.entries > q.MaxEntries || q.MaxCost > 0 && st.cost > q.MaxCost
+}
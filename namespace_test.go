@@ -0,0 +1,163 @@
+package tenure
+
+import "testing"
+
+func mustNamespace(t *testing.T, lru *LRUCache, name string) LRUController {
+	t.Helper()
+
+	ns, err := lru.Namespace(name)
+	if err != nil {
+		t.Fatalf("Failed to create namespace %v; see %v", name, err)
+	}
+
+	return ns
+}
+
+func TestNamespaceIsolation(t *testing.T) {
+	lru, err := New(10, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	a, b := mustNamespace(t, lru, "a"), mustNamespace(t, lru, "b")
+	a.Put("key", 1)
+	b.Put("key", 2)
+	lru.Put("key", 3)
+
+	if v, _ := a.Get("key"); v != 1 {
+		t.Errorf("Namespace a value mismatch; Have %v, Want %v", v, 1)
+	}
+
+	if v := b.Peek("key"); v != 2 {
+		t.Errorf("Namespace b value mismatch; Have %v, Want %v", v, 2)
+	}
+
+	if v, _ := lru.Get("key"); v != 3 {
+		t.Errorf("Unscoped value mismatch; Have %v, Want %v", v, 3)
+	}
+
+	if !lru.Has(PartitionKey{"a", "key"}) {
+		t.Error("Entries of a namespace should be visible from the cache as PartitionKeys")
+	}
+
+	a.Del("key")
+	if a.Has("key") || !b.Has("key") {
+		t.Error("Deletion should be scoped to the namespace")
+	}
+}
+
+func TestNamespaceSharesCapacity(t *testing.T) {
+	var evicted []interface{}
+	lru, err := New(3, func(key, value interface{}) { evicted = append(evicted, key) })
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	a, b := mustNamespace(t, lru, "a"), mustNamespace(t, lru, "b")
+	a.Put(1, 1)
+	a.Put(2, 2)
+	b.Put(1, 1)
+	b.Put(2, 2)
+
+	if lru.Size() != 3 || a.Size() != 1 || b.Size() != 2 {
+		t.Fatalf("Namespaces should share the capacity; Have sizes (cache=%v, a=%v, b=%v)", lru.Size(), a.Size(), b.Size())
+	}
+
+	if len(evicted) != 1 || evicted[0] != (PartitionKey{"a", 1}) {
+		t.Errorf("Evicted keys should bear their namespace; Have %v", evicted)
+	}
+
+	if keys := b.Keys(); len(keys) != 2 || keys[0] != 1 || keys[1] != 2 {
+		t.Errorf("Namespace keys mismatch; Have %v, Want %v", keys, []interface{}{1, 2})
+	}
+}
+
+func TestDropNamespace(t *testing.T) {
+	lru, err := New(10, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	a, b := mustNamespace(t, lru, "a"), mustNamespace(t, lru, "b")
+	for i := 0; i < 3; i++ {
+		a.Put(i, i)
+		b.Put(i, i)
+	}
+	lru.Put("unscoped", 0)
+
+	if n := lru.DropNamespace("a"); n != 3 {
+		t.Errorf("Dropped count mismatch; Have %v, Want %v", n, 3)
+	}
+
+	if a.Size() != 0 || len(a.Keys()) != 0 || b.Size() != 3 || !lru.Has("unscoped") {
+		t.Fatalf("Only the namespace should be dropped; Have keys %v", lru.Keys())
+	}
+
+	b.Purge()
+	if lru.Size() != 1 {
+		t.Errorf("Dropping a view should drop its namespace alone; Have size %v, Want %v", lru.Size(), 1)
+	}
+}
+
+func TestNamespaceQuota(t *testing.T) {
+	lru, err := New(10, nil, WithNamespaceQuota("tenant", NamespaceQuota{MaxEntries: 2}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	tenant, other := mustNamespace(t, lru, "tenant"), mustNamespace(t, lru, "other")
+	other.Put(0, 0)
+	tenant.Put(1, 1)
+	tenant.Put(2, 2)
+	tenant.Get(1)
+
+	if !tenant.Put(3, 3) {
+		t.Fatal("Exceeding the quota should enact the eviction policy")
+	}
+
+	if tenant.Size() != 2 || tenant.Has(2) || !tenant.Has(1) || !other.Has(0) {
+		t.Fatalf("The namespace's own least recently-used entry should be evicted; Have %v", lru.Keys())
+	}
+
+	tenant.Get(2)
+	s := lru.NamespaceStats("tenant")
+	if s.Hits != 1 || s.Misses != 1 || s.Evictions != 1 || s.Size != 2 || s.Quota.MaxEntries != 2 {
+		t.Errorf("Namespace stats mismatch; Have %+v", s)
+	}
+}
+
+func TestNamespaceCostQuota(t *testing.T) {
+	sizer := func(key, value interface{}) int64 { return int64(len(value.(string))) }
+	lru, err := New(10, nil, WithSizer(sizer), WithNamespaceQuota("tenant", NamespaceQuota{MaxCost: 5}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	tenant := mustNamespace(t, lru, "tenant")
+	tenant.Put("a", "aa")
+	tenant.Put("b", "bb")
+	if s := lru.NamespaceStats("tenant"); s.Cost != 4 {
+		t.Fatalf("Namespace cost mismatch; Have %v, Want %v", s.Cost, 4)
+	}
+
+	tenant.Put("c", "ccc")
+	if tenant.Has("a") || !tenant.Has("b") || !tenant.Has("c") {
+		t.Fatalf("Exceeding the cost quota should evict the namespace's least recently-used entries; Have %v", tenant.Keys())
+	}
+
+	tenant.Del("b")
+	if s := lru.NamespaceStats("tenant"); s.Cost != 3 || s.Size != 1 {
+		t.Errorf("Namespace stats mismatch after deletion; Have %+v", s)
+	}
+}
+
+func TestNamespaceKeyHashing(t *testing.T) {
+	lru, err := New(10, nil, WithKeyHashing([]byte("secret")))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	if _, err := lru.Namespace("a"); err == nil {
+		t.Error("Namespaces should be refused by a cache configured with key hashing")
+	}
+}
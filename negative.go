@@ -0,0 +1,31 @@
+package tenure
+
+import "time"
+
+// PutNegative caches a "not found" marker for the given key, lasting `ttl`, so that repeated misses against an
+// expensive backend are suppressed without storing a placeholder value
+// A negative entry reads as a miss via Get, Lookup, Peek and Has, but is never read through to a backing Store;
+// see Probe to distinguish it from an absent key. The marker is not written to the Store, and is superseded by
+// any subsequent Put of the key
+// A non-positive `ttl` disables expiry, in which case the marker persists until evicted, deleted or superseded
+func (lc *LRUCache) PutNegative(key interface{}, ttl time.Duration) (wasEvicted bool) {
+	return lc.insert(key, nil, entrySpec{hard: ttl, negative: true})
+}
+
+// Probe attempts to retrieve the value for the given key from the cache, as does Lookup, additionally reporting
+// whether the cache holds a negative entry for the key; see PutNegative
+// The ok flag reports whether the cache holds any answer for the key; negative is only true where ok is
+// Unlike Get, Probe never reads through to a backing Store
+func (lc *LRUCache) Probe(key interface{}) (value interface{}, negative bool, ok bool) {
+	kv, _, ok := lc.lookup(lc.mapKey(key))
+	if !ok {
+		return nil, false, false
+	}
+
+	if kv.negative {
+		return nil, true, true
+	}
+
+	value, err := lc.decode(kv.value)
+	return value, false, err == nil
+}
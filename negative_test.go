@@ -0,0 +1,98 @@
+package tenure
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestPutNegative(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(4, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutNegative("a", time.Minute)
+
+	if v, ok := lru.Get("a"); ok || v != nil {
+		t.Fatalf("Negative entries should read as misses; Have (%v, %v), Want (<nil>, false)", v, ok)
+	}
+
+	if lru.Has("a") || lru.Peek("a") != nil {
+		t.Fatal("Negative entries should not be reported as extant")
+	}
+
+	if _, negative, ok := lru.Probe("a"); !ok || !negative {
+		t.Fatalf("Probe mismatch; Have (negative=%v, ok=%v), Want (negative=true, ok=true)", negative, ok)
+	}
+
+	if _, negative, ok := lru.Probe("b"); ok || negative {
+		t.Fatalf("Absent keys should not be reported as negative; Have (negative=%v, ok=%v)", negative, ok)
+	}
+
+	if info, ok := lru.Inspect("a"); !ok || !info.Negative {
+		t.Fatalf("Inspect should report negative entries; Have %+v", info)
+	}
+
+	if s := lru.Stats(); s.NegativeHits != 2 || s.Hits != 0 {
+		t.Fatalf("Stats mismatch; Have (negativeHits=%v, hits=%v), Want (negativeHits=2, hits=0)", s.NegativeHits, s.Hits)
+	}
+
+	clock.Advance(2 * time.Minute)
+
+	if _, _, ok := lru.Probe("a"); ok {
+		t.Fatal("Negative entries should expire per their TTL")
+	}
+
+	lru.PutNegative("c", time.Minute)
+	lru.Put("c", 1)
+
+	if v, negative, ok := lru.Probe("c"); !ok || negative || v != 1 {
+		t.Fatalf("Put should supersede a negative entry; Have (%v, negative=%v, ok=%v)", v, negative, ok)
+	}
+}
+
+func TestPutNegativeSuppressesLoads(t *testing.T) {
+	ms := newMapStore()
+
+	lru, err := New(4, nil, WithStore(ms))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutNegative("a", 0)
+
+	for i := 0; i < 3; i++ {
+		if _, err := lru.GetOrLoad("a"); err != ErrNotFound {
+			t.Fatalf("Negative entries should be reported as not found; Have %v, Want %v", err, ErrNotFound)
+		}
+	}
+
+	if ms.loads != 0 || ms.writes != 0 {
+		t.Fatalf("Negative entries should neither read through nor write through; Have (loads=%v, writes=%v)", ms.loads, ms.writes)
+	}
+}
+
+func TestNegativeHandoff(t *testing.T) {
+	src, _ := New(4, nil)
+	dst, _ := New(4, nil)
+
+	src.PutNegative("a", time.Hour)
+
+	var buf bytes.Buffer
+	if err := src.Handoff(&buf); err != nil {
+		t.Fatalf("Failed to hand off; see %v", err)
+	}
+
+	if _, err := dst.Receive(&buf); err != nil {
+		t.Fatalf("Failed to receive; see %v", err)
+	}
+
+	if _, negative, ok := dst.Probe("a"); !ok || !negative {
+		t.Fatalf("Negative entries should survive handoff; Have (negative=%v, ok=%v)", negative, ok)
+	}
+}
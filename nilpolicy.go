@@ -0,0 +1,63 @@
+package tenure
+
+import "errors"
+
+// NilPolicy governs how the cache treats nil values; see WithNilPolicy
+type NilPolicy int
+
+const (
+	// NilAllow stores nil values as any other; as Peek reports absent keys as nil, Has must then be consulted
+	// to distinguish a stored nil from a miss
+	NilAllow NilPolicy = iota
+	// NilReject refuses to store nil values
+	NilReject
+	// NilSentinel stores NilValue in place of nil values, so that a cached nil is distinguishable from a miss
+	NilSentinel
+)
+
+// ErrNilValue is returned where a nil value is refused per the NilReject policy
+var ErrNilValue = errors.New("nil values are refused by the cache's nil policy")
+
+// NilValue is stored in place of nil values per the NilSentinel policy
+// It is encoded as JSON null, and so recorded as such in change streams
+var NilValue = nilSentinel{}
+
+type nilSentinel struct{}
+
+// MarshalJSON encodes the sentinel as null
+func (nilSentinel) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// String renders the sentinel as "<nil>", as fmt renders nil
+func (nilSentinel) String() string {
+	return "<nil>"
+}
+
+// WithNilPolicy configures how the cache treats untyped nil values, uniformly across Put, PutWithTTL, PutWith,
+// values loaded from a backing Store, and Replay. Per NilReject, the Put family refuses nil values, reporting no
+// eviction; GetOrLoad returns ErrNilValue for a nil value loaded from the store; and Replay halts, returning
+// ErrNilValue, upon a nil value in the log. The default policy is NilAllow
+func WithNilPolicy(p NilPolicy) Option {
+	return func(lc *LRUCache) {
+		lc.nilPolicy = p
+	}
+}
+
+/* Utilities */
+
+// admit applies the nil policy to `value`, returning the value to be stored
+func (lc *LRUCache) admit(value interface{}) (interface{}, error) {
+	if value != nil {
+		return value, nil
+	}
+
+	switch lc.nilPolicy {
+	case NilReject:
+		return nil, ErrNilValue
+	case NilSentinel:
+		return NilValue, nil
+	default:
+		return nil, nil
+	}
+}
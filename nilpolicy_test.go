@@ -0,0 +1,103 @@
+package tenure
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestNilPolicyAllow(t *testing.T) {
+	lru, err := New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", nil)
+
+	if v, ok := lru.Get("a"); !ok || v != nil {
+		t.Fatalf("Nil values should be storable by default; Have (%v, %v)", v, ok)
+	}
+}
+
+func TestNilPolicyReject(t *testing.T) {
+	store := newMapStore()
+	store.data["loaded"] = nil
+
+	lru, err := New(2, nil, WithNilPolicy(NilReject), WithStore(store))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", nil)
+	lru.PutWithTTL("b", nil, 0, time.Hour)
+	lru.PutWith("c", nil)
+
+	if lru.Size() != 0 || store.writes != 0 {
+		t.Fatalf("Nil values should be refused; Have size %v, writes %v", lru.Size(), store.writes)
+	}
+
+	if _, err := lru.GetOrLoad("loaded"); err != ErrNilValue {
+		t.Fatalf("Nil values loaded from the store should be refused; Have %v, Want %v", err, ErrNilValue)
+	}
+
+	log := `{"seq":1,"op":"put","key":"x","value":1}
+{"seq":2,"op":"put","key":"y","value":null}
+{"seq":3,"op":"put","key":"z","value":3}
+`
+	replica, err := New(2, nil, WithNilPolicy(NilReject))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	if applied, err := replica.Replay(bytes.NewBufferString(log)); err != ErrNilValue || applied != 1 {
+		t.Fatalf("Replay should halt upon a nil value; Have (%v, %v), Want (1, %v)", applied, err, ErrNilValue)
+	}
+}
+
+func TestNilPolicySentinel(t *testing.T) {
+	var buf bytes.Buffer
+
+	lru, err := New(2, nil, WithNilPolicy(NilSentinel), WithChangeStream(&buf, nil))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", nil)
+
+	if v := lru.Peek("a"); v != NilValue {
+		t.Fatalf("Nil values should be stored as the sentinel; Have %v", v)
+	}
+
+	if v := lru.Peek("b"); v != nil {
+		t.Fatalf("Misses should remain nil; Have %v", v)
+	}
+
+	replica, err := New(2, nil, WithNilPolicy(NilSentinel))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	if _, err := replica.Replay(&buf); err != nil {
+		t.Fatalf("Replay failure; see %v", err)
+	}
+
+	if v := replica.Peek("a"); v != NilValue {
+		t.Fatalf("Replayed nil values should be stored as the sentinel; Have %v", v)
+	}
+}
+
+func TestNilPolicySnapshot(t *testing.T) {
+	lru, err := New(2, nil, WithNilPolicy(NilSentinel))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	sc := NewSnapshotCache(lru, time.Hour)
+	defer sc.Close()
+
+	sc.Put("a", nil)
+
+	if v, ok := sc.Get("a"); !ok || v != NilValue {
+		t.Fatalf("Snapshot reads should observe the sentinel; Have (%v, %v)", v, ok)
+	}
+}
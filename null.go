@@ -0,0 +1,39 @@
+package tenure
+
+// NullCache satisfies LRUController but stores nothing: every Get misses and every Put is a no-op
+// It allows caching to be disabled, e.g. via configuration during an incident, without changing call sites
+type NullCache struct{}
+
+var _ LRUController = NullCache{}
+
+// Get always misses
+func (NullCache) Get(key interface{}) (value interface{}, ok bool) { return nil, false }
+
+// Put discards the given key / value pair
+func (NullCache) Put(key, value interface{}) (wasEvicted bool) { return false }
+
+// Del never deletes anything
+func (NullCache) Del(key interface{}) (wasDeleted bool) { return false }
+
+// Keys always returns an empty slice
+func (NullCache) Keys() []interface{} { return []interface{}{} }
+
+// Peek always returns nil
+func (NullCache) Peek(key interface{}) (value interface{}) { return nil }
+
+// Has always returns false
+func (NullCache) Has(key interface{}) (ok bool) { return false }
+
+// Drop is a no-op
+func (NullCache) Drop() {}
+
+// Purge is a no-op
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (NullCache) Purge() {}
+
+// Size always returns zero
+func (NullCache) Size() int { return 0 }
+
+// AdjustCapacity is a no-op
+func (NullCache) AdjustCapacity(bufCap int) (numEvicted int) { return 0 }
@@ -0,0 +1,21 @@
+package tenure
+
+import (
+	"testing"
+)
+
+func TestNullCache(t *testing.T) {
+	var c LRUController = NullCache{}
+
+	if c.Put("a", 1) {
+		t.Fatal("NullCache should never evict")
+	}
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("NullCache should always miss")
+	}
+
+	if c.Has("a") || c.Peek("a") != nil || c.Size() != 0 || len(c.Keys()) != 0 {
+		t.Fatal("NullCache should store nothing")
+	}
+}
@@ -0,0 +1,96 @@
+package tenure
+
+import "errors"
+
+// PartitionKey is the composite key under which a PartitionedCache, or a namespace view, stores its entries
+// Eviction callbacks supplied to a PartitionedCache receive a PartitionKey as the evicted key; see also Namespace
+type PartitionKey struct {
+	Partition interface{}
+	Key       interface{}
+}
+
+// PartitionStats reports per-partition usage of a PartitionedCache
+// Statistics are kept only while the partition holds entries; see Stats
+type PartitionStats struct {
+	Hits   uint64
+	Misses uint64
+	Size   int
+}
+
+// PartitionedCache is a double-keyed LRU cache wherein every entry is scoped to a partition
+// (e.g. an origin, tenant or site) in addition to its key, isolating partitions from one another:
+// a key stored in one partition is never visible from another
+// All partitions share a single capacity and eviction policy
+type PartitionedCache struct {
+	lru *LRUCache
+}
+
+// NewPartitioned initializes a new double-keyed cache with a shared buffer capacity of `bufCap`
+// Options are applied to the underlying LRUCache; WithKeyHashing is not supported, as partitions
+// must remain recoverable from stored keys
+func NewPartitioned(bufCap int, onItemEvicted Callback, opts ...Option) (*PartitionedCache, error) {
+	lru, err := New(bufCap, onItemEvicted, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if lru.keySecret != nil {
+		return nil, errors.New("a partitioned cache cannot be initialized with key hashing")
+	}
+
+	return &PartitionedCache{lru: lru}, nil
+}
+
+// Get attempts to retrieve the value for the given key within the given partition
+// The lookup counts toward the partition's statistics only if the partition holds entries
+func (pc *PartitionedCache) Get(partition, key interface{}) (value interface{}, ok bool) {
+	value, ok = pc.lru.Get(PartitionKey{partition, key})
+	pc.lru.lookedUp(partition, ok)
+
+	return
+}
+
+// Put adds or inserts a given key / value pair into the given partition
+// Returns a boolean flag indicating whether an eviction occurred
+func (pc *PartitionedCache) Put(partition, key, value interface{}) (wasEvicted bool) {
+	return pc.lru.Put(PartitionKey{partition, key}, value)
+}
+
+// Del deletes the item corresponding to a given key within the given partition, if extant
+func (pc *PartitionedCache) Del(partition, key interface{}) (wasDeleted bool) {
+	return pc.lru.Del(PartitionKey{partition, key})
+}
+
+// PurgePartition atomically deletes every item within the given partition, returning the number of items removed
+// Purged items do not invoke the eviction callback; as with Drop, the backing Store, if any, is left as is
+func (pc *PartitionedCache) PurgePartition(partition interface{}) (numPurged int) {
+	return pc.lru.purgeNamespace(partition, false)
+}
+
+// Stats returns usage statistics for the given partition
+// Size counts the entries stored within the partition, as accounted for by the underlying cache itself
+// A partition's statistics are discarded once it holds no entries, whereupon all are reported as zero
+func (pc *PartitionedCache) Stats(partition interface{}) PartitionStats {
+	s := pc.lru.namespaceStats(partition)
+	return PartitionStats{Hits: s.Hits, Misses: s.Misses, Size: s.Size}
+}
+
+// Partitions returns the partitions currently holding entries
+func (pc *PartitionedCache) Partitions() []interface{} {
+	pc.lru.lock.RLock()
+	defer pc.lru.lock.RUnlock()
+
+	partitions := make([]interface{}, 0, len(pc.lru.namespaces))
+	for p, st := range pc.lru.namespaces {
+		if st.entries > 0 {
+			partitions = append(partitions, p)
+		}
+	}
+
+	return partitions
+}
+
+// Size returns the current size of the cache across all partitions
+func (pc *PartitionedCache) Size() int {
+	return pc.lru.Size()
+}
@@ -0,0 +1,142 @@
+package tenure
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestPartitionIsolation(t *testing.T) {
+	evictions := 0
+
+	pc, err := NewPartitioned(4, func(k interface{}, v interface{}) {
+		if _, ok := k.(PartitionKey); !ok {
+			t.Fatalf("Evicted key should be a PartitionKey; Have %T", k)
+		}
+		evictions++
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize a new partitioned cache instance; see %v", err)
+	}
+
+	pc.Put("a.com", "session", 1)
+	pc.Put("b.com", "session", 2)
+
+	if v, ok := pc.Get("a.com", "session"); !ok || v != 1 {
+		t.Fatalf("Partition read failure; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	if v, ok := pc.Get("b.com", "session"); !ok || v != 2 {
+		t.Fatalf("Partition read failure; Have (%v, %v), Want (2, true)", v, ok)
+	}
+
+	if _, ok := pc.Get("c.com", "session"); ok {
+		t.Fatal("Keys should not be visible across partitions")
+	}
+
+	if s := pc.Stats("a.com"); s.Size != 1 || s.Hits != 1 {
+		t.Fatalf("Partition stats mismatch; Have %+v", s)
+	}
+
+	pc.Put("b.com", "x", 3)
+	pc.Put("b.com", "y", 4)
+	pc.Put("b.com", "z", 5)
+
+	if evictions != 1 {
+		t.Fatalf("Eviction policy failure; Have %v evictions, Want %v evictions", evictions, 1)
+	}
+
+	// The partition's statistics are discarded along with its last entry
+	if s := pc.Stats("a.com"); s != (PartitionStats{}) {
+		t.Fatalf("Partition stats mismatch; Have %+v", s)
+	}
+
+	if n := pc.PurgePartition("b.com"); n != 4 {
+		t.Fatalf("Purge failure; Have %v purged, Want %v purged", n, 4)
+	}
+
+	if pc.Size() != 0 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", pc.Size(), 0)
+	}
+
+	if s := pc.Stats("c.com"); s.Misses != 0 {
+		t.Fatalf("Lookups within absent partitions should not be tracked; Have %v misses", s.Misses)
+	}
+}
+
+func TestPartitionStateIsPruned(t *testing.T) {
+	pc, err := NewPartitioned(4, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new partitioned cache instance; see %v", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		pc.Get(i, "session")
+	}
+
+	pc.Put("a.com", "x", 1)
+	pc.Put("a.com", "y", 2)
+	pc.Put("b.com", "x", 3)
+
+	if n := len(pc.Partitions()); n != 2 || len(pc.lru.namespaces) != 2 {
+		t.Fatalf("Only partitions holding entries should be tracked; Have %v partitions, %v states", n, len(pc.lru.namespaces))
+	}
+
+	pc.Del("b.com", "x")
+	if n := pc.PurgePartition("a.com"); n != 2 {
+		t.Fatalf("Purge failure; Have %v purged, Want %v purged", n, 2)
+	}
+
+	if n := len(pc.Partitions()); n != 0 || len(pc.lru.namespaces) != 0 {
+		t.Errorf("Emptied partitions should be pruned; Have %v partitions, %v states", n, len(pc.lru.namespaces))
+	}
+}
+
+func TestPartitionSizeAfterExpiry(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	pc, err := NewPartitioned(4, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new partitioned cache instance; see %v", err)
+	}
+
+	pc.lru.PutWithTTL(PartitionKey{"a.com", "session"}, 1, 0, time.Second)
+	clock.Advance(2 * time.Second)
+
+	// The expired entry is overwritten in place
+	pc.Put("a.com", "session", 2)
+	if s := pc.Stats("a.com"); s.Size != 1 {
+		t.Errorf("Partition size mismatch; Have %v, Want %v", s.Size, 1)
+	}
+}
+
+func TestPartitionBackgroundEviction(t *testing.T) {
+	pc, err := NewPartitioned(64, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new partitioned cache instance; see %v", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			pc.lru.AdjustCapacity(32 + i%32)
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		pc.Put(i%4, i, i)
+	}
+	wg.Wait()
+
+	size := 0
+	for p := 0; p < 4; p++ {
+		size += pc.Stats(p).Size
+	}
+
+	if size != pc.Size() {
+		t.Errorf("Partition sizes should sum to the size of the cache; Have %v, Want %v", size, pc.Size())
+	}
+}
@@ -0,0 +1,16 @@
+package peer
+
+import "context"
+
+// Discoverer discovers the set of peers forming a group, e.g. from a service registry
+type Discoverer interface {
+	// Watch invokes `update` with the complete set of peers whenever it changes, until `ctx` is done
+	Watch(ctx context.Context, update func(peers []string)) error
+}
+
+// Discover keeps the pool's set of peers current per `d`, blocking until `ctx` is done or `d` fails
+func (p *HTTPPool) Discover(ctx context.Context, d Discoverer) error {
+	return d.Watch(ctx, func(peers []string) {
+		p.Set(peers...)
+	})
+}
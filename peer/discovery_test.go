@@ -0,0 +1,34 @@
+package peer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestHTTPPoolDiscover(t *testing.T) {
+	pool := NewHTTPPool("http://self")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	pool.Discover(ctx, discovererFunc(func(ctx context.Context, update func([]string)) error {
+		update([]string{"http://self", "http://other"})
+		return ctx.Err()
+	}))
+
+	remote := false
+	for i := 0; i < 100 && !remote; i++ {
+		_, remote = pool.PickPeer(fmt.Sprint(i))
+	}
+
+	if !remote {
+		t.Fatal("Discovered peers should be adopted by the pool")
+	}
+}
+
+type discovererFunc func(ctx context.Context, update func([]string)) error
+
+func (f discovererFunc) Watch(ctx context.Context, update func([]string)) error {
+	return f(ctx, update)
+}
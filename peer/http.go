@@ -0,0 +1,149 @@
+package peer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/MatthewZito/tenure-go/internal/consistent"
+)
+
+// DefaultBasePath is the path prefix under which an HTTPPool serves peer requests
+const DefaultBasePath = "/_tenure/"
+
+// defaultReplicas is the number of virtual nodes per peer on the hash ring
+const defaultReplicas = 64
+
+// HTTPPool is a PeerPicker for a pool of HTTP peers, and the http.Handler serving the local peer's groups
+// Peers are identified by their base URL, e.g. "http://10.0.0.1:8000"
+type HTTPPool struct {
+	self     string
+	basePath string
+	client   *http.Client
+	mu       sync.RWMutex
+	ring     *consistent.Ring
+	fetchers map[string]*httpFetcher
+	groups   map[string]*Group
+}
+
+// NewHTTPPool initializes a new HTTPPool for the local peer designated by `self`
+func NewHTTPPool(self string) *HTTPPool {
+	return &HTTPPool{
+		self:     self,
+		basePath: DefaultBasePath,
+		client:   http.DefaultClient,
+		ring:     consistent.New(defaultReplicas, nil),
+		fetchers: make(map[string]*httpFetcher),
+		groups:   make(map[string]*Group),
+	}
+}
+
+// NewGroup initializes a new Group partitioned across the pool, and registers it to be served to other peers
+func (p *HTTPPool) NewGroup(name string, getter Getter, cfg Config) (*Group, error) {
+	g, err := NewGroup(name, getter, p, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.groups[name] = g
+	return g, nil
+}
+
+// Set replaces the pool's set of peers, which should include the local peer
+func (p *HTTPPool) Set(peers ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.ring = consistent.New(defaultReplicas, nil)
+	p.ring.Add(peers...)
+
+	p.fetchers = make(map[string]*httpFetcher, len(peers))
+	for _, peer := range peers {
+		p.fetchers[peer] = &httpFetcher{client: p.client, base: peer + p.basePath}
+	}
+}
+
+// PickPeer returns the remote peer owning the given key, or false if it is owned by the local peer
+func (p *HTTPPool) PickPeer(key string) (Fetcher, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if peer := p.ring.Get(key); peer != "" && peer != p.self {
+		return p.fetchers[peer], true
+	}
+
+	return nil, false
+}
+
+// ServeHTTP serves requests of the form <basePath><group>/<key> from other peers
+func (p *HTTPPool) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The path is split in its escaped form, so that neither an escaped slash nor a literal percent sign within
+	// the group or key is mistaken for structure, and each part is then unescaped exactly once
+	path := r.URL.EscapedPath()
+	if !strings.HasPrefix(path, p.basePath) {
+		http.NotFound(w, r)
+		return
+	}
+
+	parts := strings.SplitN(path[len(p.basePath):], "/", 2)
+	if len(parts) != 2 {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	group, err := url.PathUnescape(parts[0])
+	if err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	key, err := url.PathUnescape(parts[1])
+	if err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	p.mu.RLock()
+	g, ok := p.groups[group]
+	p.mu.RUnlock()
+
+	if !ok {
+		http.Error(w, "no such group: "+group, http.StatusNotFound)
+		return
+	}
+
+	// Requests from peers are always served locally, so as to never bounce between peers
+	v, err := g.getLocal(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(v)
+}
+
+type httpFetcher struct {
+	client *http.Client
+	base   string
+}
+
+func (f *httpFetcher) Fetch(group, key string) ([]byte, error) {
+	res, err := f.client.Get(f.base + url.PathEscape(group) + "/" + url.PathEscape(key))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("peer responded with %v", res.Status)
+	}
+
+	return ioutil.ReadAll(res.Body)
+}
@@ -0,0 +1,308 @@
+package peer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// serviceAccountDir is where Kubernetes mounts a pod's service account credentials
+const serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// KubernetesDiscovery discovers peers as the ready endpoints of a Kubernetes Service, watching its
+// EndpointSlices so the set of peers follows pods as they scale. The pod's service account must be
+// permitted to list and watch endpointslices in the discovery.k8s.io API group
+type KubernetesDiscovery struct {
+	// Namespace and Service designate the Service whose endpoints are peers
+	Namespace string
+	Service   string
+	// PortName selects the named port of the Service's endpoints; if empty, the first port is selected
+	PortName string
+	// Scheme is prefixed to each peer's address to form its base URL; it defaults to "http"
+	Scheme string
+	// APIServer is the base URL of the Kubernetes API server
+	APIServer string
+	// Token is the bearer token authenticating requests to the API server
+	Token string
+	// Client issues requests to the API server
+	Client *http.Client
+	// RetryInterval is the pause before re-establishing a failed watch; it defaults to five seconds
+	RetryInterval time.Duration
+
+	mu     sync.Mutex
+	slices map[string][]string
+	last   []string
+}
+
+// NewKubernetesDiscovery initializes a new KubernetesDiscovery for the given Service, configured to reach the
+// API server from within a pod by way of the environment and the pod's mounted service account
+func NewKubernetesDiscovery(namespace, service string) (*KubernetesDiscovery, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("not running within a Kubernetes pod")
+	}
+
+	token, err := ioutil.ReadFile(serviceAccountDir + "/token")
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := ioutil.ReadFile(serviceAccountDir + "/ca.crt")
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("invalid service account CA certificate")
+	}
+
+	if namespace == "" {
+		ns, err := ioutil.ReadFile(serviceAccountDir + "/namespace")
+		if err != nil {
+			return nil, err
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+
+	return &KubernetesDiscovery{
+		Namespace: namespace,
+		Service:   service,
+		APIServer: "https://" + net.JoinHostPort(host, port),
+		Token:     strings.TrimSpace(string(token)),
+		Client: &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		}},
+	}, nil
+}
+
+// Watch lists the Service's EndpointSlices, then watches them for changes, invoking `update` with the base
+// URLs of every ready endpoint whenever they change; failed watches are re-established after RetryInterval
+// Watch returns only once `ctx` is done
+func (k *KubernetesDiscovery) Watch(ctx context.Context, update func(peers []string)) error {
+	retry := k.RetryInterval
+	if retry <= 0 {
+		retry = 5 * time.Second
+	}
+
+	for {
+		version, err := k.list(ctx, update)
+		if err == nil {
+			k.watch(ctx, version, update)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retry):
+		}
+	}
+}
+
+/* Utilities */
+
+type endpointSlice struct {
+	Metadata struct {
+		Name            string `json:"name"`
+		ResourceVersion string `json:"resourceVersion"`
+	} `json:"metadata"`
+	Endpoints []struct {
+		Addresses  []string `json:"addresses"`
+		Conditions struct {
+			Ready *bool `json:"ready"`
+		} `json:"conditions"`
+	} `json:"endpoints"`
+	Ports []struct {
+		Name string `json:"name"`
+		Port int    `json:"port"`
+	} `json:"ports"`
+}
+
+type endpointSliceList struct {
+	Metadata struct {
+		ResourceVersion string `json:"resourceVersion"`
+	} `json:"metadata"`
+	Items []endpointSlice `json:"items"`
+}
+
+type watchEvent struct {
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+func (k *KubernetesDiscovery) list(ctx context.Context, update func(peers []string)) (string, error) {
+	res, err := k.get(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	var l endpointSliceList
+	if err := json.NewDecoder(res.Body).Decode(&l); err != nil {
+		return "", err
+	}
+
+	k.mu.Lock()
+	k.slices = make(map[string][]string, len(l.Items))
+	for _, s := range l.Items {
+		k.slices[s.Metadata.Name] = k.peers(s)
+	}
+	k.mu.Unlock()
+
+	k.publish(update)
+	return l.Metadata.ResourceVersion, nil
+}
+
+func (k *KubernetesDiscovery) watch(ctx context.Context, version string, update func(peers []string)) error {
+	res, err := k.get(ctx, url.Values{
+		"watch":               {"true"},
+		"resourceVersion":     {version},
+		"allowWatchBookmarks": {"true"},
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	dec := json.NewDecoder(res.Body)
+	for {
+		var e watchEvent
+		if err := dec.Decode(&e); err != nil {
+			return err
+		}
+
+		var s endpointSlice
+		switch e.Type {
+		case "ADDED", "MODIFIED", "DELETED":
+			if err := json.Unmarshal(e.Object, &s); err != nil {
+				return err
+			}
+		case "ERROR":
+			// typically a 410 Gone, whereupon the slices must be listed afresh
+			return fmt.Errorf("watch failed: %s", e.Object)
+		default:
+			continue
+		}
+
+		k.mu.Lock()
+		if e.Type == "DELETED" {
+			delete(k.slices, s.Metadata.Name)
+		} else {
+			k.slices[s.Metadata.Name] = k.peers(s)
+		}
+		k.mu.Unlock()
+
+		k.publish(update)
+	}
+}
+
+func (k *KubernetesDiscovery) get(ctx context.Context, q url.Values) (*http.Response, error) {
+	if q == nil {
+		q = url.Values{}
+	}
+	q.Set("labelSelector", "kubernetes.io/service-name="+k.Service)
+
+	u := fmt.Sprintf("%s/apis/discovery.k8s.io/v1/namespaces/%s/endpointslices?%s",
+		strings.TrimSuffix(k.APIServer, "/"), url.PathEscape(k.Namespace), q.Encode())
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	if k.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+k.Token)
+	}
+
+	client := k.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("kubernetes API server responded %s", res.Status)
+	}
+
+	return res, nil
+}
+
+// peers returns the base URLs of a slice's ready endpoints
+func (k *KubernetesDiscovery) peers(s endpointSlice) []string {
+	port := 0
+	for _, p := range s.Ports {
+		if k.PortName == "" || p.Name == k.PortName {
+			port = p.Port
+			break
+		}
+	}
+
+	if port == 0 {
+		return nil
+	}
+
+	scheme := k.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+
+	var peers []string
+	for _, e := range s.Endpoints {
+		// per the API, an unknown readiness should be interpreted as ready
+		if e.Conditions.Ready != nil && !*e.Conditions.Ready {
+			continue
+		}
+
+		for _, addr := range e.Addresses {
+			peers = append(peers, scheme+"://"+net.JoinHostPort(addr, strconv.Itoa(port)))
+		}
+	}
+
+	return peers
+}
+
+// publish invokes `update` with the current set of peers, should it differ from that last published
+func (k *KubernetesDiscovery) publish(update func(peers []string)) {
+	k.mu.Lock()
+
+	seen := make(map[string]bool)
+	peers := []string{}
+	for _, ps := range k.slices {
+		for _, p := range ps {
+			if !seen[p] {
+				seen[p] = true
+				peers = append(peers, p)
+			}
+		}
+	}
+	sort.Strings(peers)
+
+	changed := k.last == nil || strings.Join(peers, "\n") != strings.Join(k.last, "\n")
+	if changed {
+		k.last = peers
+	}
+	k.mu.Unlock()
+
+	if changed {
+		update(peers)
+	}
+}
@@ -0,0 +1,79 @@
+package peer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func slice(name string, ready map[string]bool) string {
+	eps := ""
+	for addr, r := range ready {
+		if eps != "" {
+			eps += ","
+		}
+		eps += fmt.Sprintf(`{"addresses":[%q],"conditions":{"ready":%v}}`, addr, r)
+	}
+
+	return fmt.Sprintf(`{"metadata":{"name":%q},"endpoints":[%s],"ports":[{"name":"metrics","port":9090},{"name":"http","port":8080}]}`, name, eps)
+}
+
+func TestKubernetesDiscovery(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/discovery.k8s.io/v1/namespaces/default/endpointslices" ||
+			r.URL.Query().Get("labelSelector") != "kubernetes.io/service-name=cache" ||
+			r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		if r.URL.Query().Get("watch") != "true" {
+			fmt.Fprintf(w, `{"metadata":{"resourceVersion":"1"},"items":[%s]}`, slice("cache-a", map[string]bool{"10.0.0.1": true, "10.0.0.2": false}))
+			return
+		}
+
+		if r.URL.Query().Get("resourceVersion") != "1" {
+			http.Error(w, "bad resource version", http.StatusBadRequest)
+			return
+		}
+
+		fmt.Fprintf(w, `{"type":"BOOKMARK","object":{}}`+"\n")
+		fmt.Fprintf(w, `{"type":"ADDED","object":%s}`+"\n", slice("cache-b", map[string]bool{"10.0.0.3": true}))
+		fmt.Fprintf(w, `{"type":"MODIFIED","object":%s}`+"\n", slice("cache-a", map[string]bool{"10.0.0.2": true}))
+		fmt.Fprintf(w, `{"type":"DELETED","object":%s}`+"\n", slice("cache-b", nil))
+		w.(http.Flusher).Flush()
+
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	k := &KubernetesDiscovery{Namespace: "default", Service: "cache", PortName: "http", APIServer: srv.URL, Token: "secret"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates := make(chan []string, 8)
+	go k.Watch(ctx, func(peers []string) { updates <- peers })
+
+	want := [][]string{
+		{"http://10.0.0.1:8080"},
+		{"http://10.0.0.1:8080", "http://10.0.0.3:8080"},
+		{"http://10.0.0.2:8080", "http://10.0.0.3:8080"},
+		{"http://10.0.0.2:8080"},
+	}
+
+	for _, w := range want {
+		select {
+		case have := <-updates:
+			if !reflect.DeepEqual(have, w) {
+				t.Fatalf("Peers mismatch; Have %v, Want %v", have, w)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out awaiting peers %v", w)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Package peer implements a distributed mode wherein multiple tenure instances form a peer group,
+// in the style of groupcache: keys are partitioned among peers by consistent hashing, a miss for a
+// key owned by another peer is fetched from that peer, and frequently fetched remote keys are
+// replicated into a local hot cache. Every cache within a group enacts tenure's eviction policy
+package peer
+
+import (
+	"errors"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/internal/singleflight"
+)
+
+// Getter loads the value for a key on the peer that owns it
+type Getter func(key string) ([]byte, error)
+
+// Fetcher retrieves the value for a key within a group from a remote peer
+type Fetcher interface {
+	Fetch(group, key string) ([]byte, error)
+}
+
+// PeerPicker selects the remote peer owning a key
+// PickPeer returns false if the key is owned by the local peer
+type PeerPicker interface {
+	PickPeer(key string) (Fetcher, bool)
+}
+
+// Config configures a Group
+type Config struct {
+	// Capacity is the capacity of the cache of keys owned by the local peer
+	Capacity int
+	// HotCapacity is the capacity of the cache of replicated remote keys; defaults to an eighth of Capacity
+	HotCapacity int
+	// HotThreshold is the number of remote fetches of a key after which it is replicated locally; defaults to 2
+	HotThreshold int
+}
+
+// Group is a named cache namespace partitioned across a set of peers
+type Group struct {
+	name   string
+	getter Getter
+	peers  PeerPicker
+	main   *tenure.LRUCache
+	hot    *tenure.LRUCache
+	counts *tenure.LRUCache
+	cfg    Config
+	flight singleflight.Group
+}
+
+// NewGroup initializes a new Group, loading keys owned by the local peer via `getter`
+// If `peers` is nil, every key is owned by the local peer
+func NewGroup(name string, getter Getter, peers PeerPicker, cfg Config) (*Group, error) {
+	if getter == nil {
+		return nil, errors.New("a peer group must be initialized with a getter")
+	}
+
+	if cfg.HotCapacity <= 0 {
+		cfg.HotCapacity = cfg.Capacity/8 + 1
+	}
+
+	if cfg.HotThreshold <= 0 {
+		cfg.HotThreshold = 2
+	}
+
+	main, err := tenure.New(cfg.Capacity, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hot, err := tenure.New(cfg.HotCapacity, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := tenure.New(cfg.HotCapacity*4, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Group{name: name, getter: getter, peers: peers, main: main, hot: hot, counts: counts, cfg: cfg}, nil
+}
+
+// Name returns the name of the group
+func (g *Group) Name() string {
+	return g.name
+}
+
+// Get retrieves the value for the given key, fetching it from its owning peer or loading it locally on a miss
+// Concurrent misses for the same key are de-duplicated
+func (g *Group) Get(key string) ([]byte, error) {
+	if v, ok := g.main.Get(key); ok {
+		return v.([]byte), nil
+	}
+
+	if v, ok := g.hot.Get(key); ok {
+		return v.([]byte), nil
+	}
+
+	return g.do(key, func() ([]byte, error) {
+		if g.peers != nil {
+			if f, ok := g.peers.PickPeer(key); ok {
+				if v, err := f.Fetch(g.name, key); err == nil {
+					g.replicate(key, v)
+					return v, nil
+				}
+				// Fall back to loading locally should the owning peer be unavailable
+			}
+		}
+
+		return g.load(key)
+	})
+}
+
+// Remove evicts the given key from the local peer's caches
+// Remove does not propagate to other peers; see the Invalidator interface for coherent invalidation
+func (g *Group) Remove(key string) {
+	g.main.Del(key)
+	g.hot.Del(key)
+	g.counts.Del(key)
+}
+
+// Stats returns the usage statistics of the local peer's main and hot caches
+func (g *Group) Stats() (main, hot tenure.Stats) {
+	return g.main.Stats(), g.hot.Stats()
+}
+
+/* Utilities */
+
+// getLocal retrieves the value for a key owned by the local peer, loading it on a miss
+func (g *Group) getLocal(key string) ([]byte, error) {
+	if v, ok := g.main.Get(key); ok {
+		return v.([]byte), nil
+	}
+
+	return g.do(key, func() ([]byte, error) {
+		return g.load(key)
+	})
+}
+
+// do de-duplicates concurrent invocations of `fn` for the same key
+func (g *Group) do(key string, fn func() ([]byte, error)) ([]byte, error) {
+	v, err := g.flight.Do(key, func() (interface{}, error) {
+		return fn()
+	})
+
+	b, _ := v.([]byte)
+	return b, err
+}
+
+func (g *Group) load(key string) ([]byte, error) {
+	v, err := g.getter(key)
+	if err != nil {
+		return nil, err
+	}
+
+	g.main.Put(key, v)
+	return v, nil
+}
+
+func (g *Group) replicate(key string, v []byte) {
+	n := 1
+	if c, ok := g.counts.Get(key); ok {
+		n = c.(int) + 1
+	}
+
+	if n >= g.cfg.HotThreshold {
+		g.hot.Put(key, v)
+		g.counts.Del(key)
+		return
+	}
+
+	g.counts.Put(key, n)
+}
@@ -0,0 +1,103 @@
+package peer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestPeerGroup(t *testing.T) {
+	var mu sync.Mutex
+	loads := make(map[string]int)
+
+	getter := func(self string) Getter {
+		return func(key string) ([]byte, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			loads[self+"/"+key]++
+			return []byte("v:" + key), nil
+		}
+	}
+
+	pools := make([]*HTTPPool, 2)
+	groups := make([]*Group, 2)
+	urls := make([]string, 2)
+
+	for i := range pools {
+		var pool *HTTPPool
+		srv := httptest.NewServer(nil)
+		defer srv.Close()
+
+		urls[i] = srv.URL
+		pool = NewHTTPPool(srv.URL)
+		srv.Config.Handler = pool
+		pools[i] = pool
+
+		g, err := pool.NewGroup("users", getter(srv.URL), Config{Capacity: 16, HotThreshold: 2})
+		if err != nil {
+			t.Fatalf("Failed to initialize a new peer group; see %v", err)
+		}
+		groups[i] = g
+	}
+
+	for _, p := range pools {
+		p.Set(urls...)
+	}
+
+	// Find a key owned by the second peer
+	var key string
+	for i := 0; i < 1000; i++ {
+		k := strconv.Itoa(i)
+		if _, remote := pools[0].PickPeer(k); remote {
+			key = k
+			break
+		}
+	}
+
+	if key == "" {
+		t.Fatal("Expected the hash ring to assign a key to the remote peer")
+	}
+
+	for i := 0; i < 3; i++ {
+		v, err := groups[0].Get(key)
+		if err != nil || string(v) != "v:"+key {
+			t.Fatalf("Peer fetch failure; Have (%s, %v), Want (v:%s, nil)", v, err, key)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if loads[urls[1]+"/"+key] != 1 || loads[urls[0]+"/"+key] != 0 {
+		t.Fatalf("Keys should only be loaded by their owner; Have %v", loads)
+	}
+
+	if _, hot := groups[0].Stats(); hot.ListLen != 1 {
+		t.Fatalf("Hot remote keys should be replicated locally; Have %v hot entries, Want %v", hot.ListLen, 1)
+	}
+}
+
+func TestHTTPPoolEscaping(t *testing.T) {
+	srv := httptest.NewServer(nil)
+	defer srv.Close()
+
+	pool := NewHTTPPool(srv.URL)
+	srv.Config.Handler = pool
+
+	if _, err := pool.NewGroup("a/b%", func(key string) ([]byte, error) {
+		return []byte(key), nil
+	}, Config{Capacity: 16}); err != nil {
+		t.Fatalf("Failed to initialize a new peer group; see %v", err)
+	}
+
+	f := &httpFetcher{client: http.DefaultClient, base: srv.URL + DefaultBasePath}
+	for _, key := range []string{"50%off", "a%41", "a/b", "%2F", "x y", "100%"} {
+		v, err := f.Fetch("a/b%", key)
+		if err != nil || string(v) != key {
+			t.Errorf("Key should round-trip verbatim; Have (%q, %v), Want %q", v, err, key)
+		}
+	}
+}
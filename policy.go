@@ -0,0 +1,118 @@
+package tenure
+
+import (
+	"math/rand"
+	"time"
+)
+
+// EvictionPolicy designates how the cache selects entries for eviction
+type EvictionPolicy int
+
+const (
+	// EvictLRU evicts the least recently-used entry; hits move entries to the front of the recency list
+	EvictLRU EvictionPolicy = iota
+	// EvictFIFO evicts the entry inserted earliest; neither hits nor updates reorder entries
+	EvictFIFO
+	// EvictRandom evicts an entry chosen uniformly at random; neither hits nor updates reorder entries
+	// Selecting a victim takes time linear in the number of entries
+	EvictRandom
+	// EvictSieve evicts per the SIEVE algorithm: hits merely mark entries as visited, and a hand sweeping from the
+	// eldest entry toward the newest evicts the first unvisited entry it meets, clearing the marks it passes over
+	// Hits thus never reorder entries, which suits read-heavy caches, while entries in use are retained much as
+	// under LRU eviction
+	EvictSieve
+	// EvictLRUK evicts the entry whose k-th most recent reference is the eldest; k is two unless configured
+	// WithLRUK
+	EvictLRUK
+)
+
+// WithEvictionPolicy configures the policy by which the cache selects entries for eviction; absent this option,
+// the least recently-used entry is evicted
+// FIFO, random and SIEVE eviction spare hits the reordering of the recency list, for workloads where recency
+// tracking isn't worthwhile. Entries are stored, expired and reserved alike under every policy; the recency list
+// then records insertion order, such that LeastRecentlyUsed and Keys report the eldest entries first. Touch has
+// no effect on the order of entries but for LRU eviction
+func WithEvictionPolicy(p EvictionPolicy) Option {
+	return func(lc *LRUCache) {
+		lc.policy = p
+		if p == EvictLRUK && lc.lruK == 0 {
+			lc.lruK = 2
+		}
+	}
+}
+
+/* Utilities */
+
+// promote designates the entry as most recently-used, where the eviction policy tracks recency
+// The write lock must be held
+func (lc *LRUCache) promote(kv *pair) {
+	switch lc.policy {
+	case EvictLRU:
+		lc.links.MoveToFront(kv)
+	case EvictSieve:
+		kv.visited = true
+	case EvictLRUK:
+		lc.links.MoveToFront(kv)
+		lc.reference(kv)
+	}
+}
+
+// randomVictim selects an entry uniformly at random from those, other than the most recently inserted, that may be
+// evicted without encroaching upon a reservation's minimum share, falling back to the eldest entry
+// The cache's source of randomness is seeded upon first use; the write lock must be held
+func (lc *LRUCache) randomVictim() *pair {
+	if lc.rng == nil {
+		lc.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	front := lc.links.Front()
+
+	var chosen *pair
+	seen := 0
+	for kv := lc.links.Back(); kv != nil && kv != front; kv = kv.Prev() {
+		if r := kv.class; r == nil || r.count > r.slots {
+			if seen++; lc.rng.Intn(seen) == 0 {
+				chosen = kv
+			}
+		}
+	}
+
+	if chosen == nil {
+		return lc.links.Back()
+	}
+
+	return chosen
+}
+
+// sieveVictim advances the hand toward the newest entry, clearing the visited marks it passes over, until it meets
+// an unvisited entry, other than the most recently inserted, that may be evicted without encroaching upon a
+// reservation's minimum share. The hand wraps around to the eldest entry; should two sweeps find no candidate,
+// the eldest entry is selected regardless
+func (lc *LRUCache) sieveVictim() *pair {
+	front := lc.links.Front()
+
+	kv := lc.hand
+	for i := 0; i < 2*lc.links.Len(); i++ {
+		if kv == nil {
+			kv = lc.links.Back()
+		}
+
+		if kv.visited {
+			kv.visited = false
+		} else if r := kv.class; kv != front && (r == nil || r.count > r.slots) {
+			lc.hand = kv
+			return kv
+		}
+
+		kv = kv.Prev()
+	}
+
+	return lc.links.Back()
+}
+
+// passHand moves the hand off an entry about to be removed from the recency list
+func (lc *LRUCache) passHand(kv *pair) {
+	if lc.hand == kv {
+		lc.hand = kv.Prev()
+	}
+}
@@ -0,0 +1,210 @@
+package tenure
+
+import "testing"
+
+func TestFIFOEviction(t *testing.T) {
+	lru, err := New(3, nil, WithEvictionPolicy(EvictFIFO))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+
+	// Neither hits nor updates should spare the eldest entry
+	lru.Get("a")
+	lru.Put("a", 10)
+	lru.Touch("a")
+
+	if !lru.Put("d", 4) {
+		t.Fatal("Exceeding the capacity should enact the eviction policy")
+	}
+
+	if lru.Has("a") || !lru.Has("b") || !lru.Has("d") {
+		t.Fatalf("FIFO eviction should remove the entry inserted earliest; Have %v", lru.Keys())
+	}
+}
+
+func TestRandomEviction(t *testing.T) {
+	lru, err := New(10, nil, WithEvictionPolicy(EvictRandom))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	evicted := make(map[interface{}]bool)
+	for round := 0; round < 20; round++ {
+		lru.Drop()
+		for i := 0; i < 10; i++ {
+			lru.Put(i, i)
+		}
+
+		lru.Put("new", 0)
+		if lru.Size() != 10 || !lru.Has("new") {
+			t.Fatalf("Random eviction should spare the inserted entry; Have %v", lru.Keys())
+		}
+
+		for i := 0; i < 10; i++ {
+			if !lru.Has(i) {
+				evicted[i] = true
+			}
+		}
+	}
+
+	if len(evicted) < 2 {
+		t.Errorf("Random eviction should not consistently select the same entry; Have %v distinct victims", len(evicted))
+	}
+}
+
+func TestRandomEvictionIsUniform(t *testing.T) {
+	const rounds = 4000
+
+	lru, err := New(4, nil, WithEvictionPolicy(EvictRandom))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	evictions := make([]int, 4)
+	for round := 0; round < rounds; round++ {
+		lru.Drop()
+		for i := 0; i < 4; i++ {
+			lru.Put(i, i)
+		}
+
+		lru.Put("new", 0)
+		for i := 0; i < 4; i++ {
+			if !lru.Has(i) {
+				evictions[i]++
+			}
+		}
+	}
+
+	// Each entry is evicted a quarter of the time; the bounds lie some ten standard deviations either side
+	for i, n := range evictions {
+		if n < rounds/4-300 || n > rounds/4+300 {
+			t.Errorf("Random eviction should select victims uniformly; Have %v evictions of %v, Want ~%v", n, i, rounds/4)
+		}
+	}
+}
+
+func TestRandomEvictionHonorsReservations(t *testing.T) {
+	lru, err := New(4, nil, WithEvictionPolicy(EvictRandom), WithPrefixReservation("vip:", 2))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("vip:a", 1)
+	lru.Put("vip:b", 2)
+	for i := 0; i < 20; i++ {
+		lru.Put(i, i)
+	}
+
+	if !lru.Has("vip:a") || !lru.Has("vip:b") {
+		t.Fatalf("Random eviction should not encroach upon a reservation; Have %v", lru.Keys())
+	}
+}
+
+func TestSieveEviction(t *testing.T) {
+	lru, err := New(3, nil, WithEvictionPolicy(EvictSieve))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+	lru.Get("a")
+
+	// The hand passes over the visited eldest entry, evicting the next
+	lru.Put("d", 4)
+	if !lru.Has("a") || lru.Has("b") || !lru.Has("c") || !lru.Has("d") {
+		t.Fatalf("SIEVE eviction should spare visited entries; Have %v", lru.Keys())
+	}
+
+	if keys := lru.Keys(); keys[0] != "a" {
+		t.Fatalf("Hits should not reorder entries under SIEVE eviction; Have %v", keys)
+	}
+
+	// The hand resumes from its position, and a's mark has since been cleared
+	lru.Put("e", 5)
+	if !lru.Has("a") || lru.Has("c") {
+		t.Fatalf("The hand should resume where it left off; Have %v", lru.Keys())
+	}
+
+	// The hand sweeps toward the newest entry before wrapping around to a
+	lru.Put("f", 6)
+	if !lru.Has("a") || lru.Has("d") {
+		t.Fatalf("The hand should sweep toward the newest entry; Have %v", lru.Keys())
+	}
+}
+
+func TestSieveDeletionUnderHand(t *testing.T) {
+	lru, err := New(3, nil, WithEvictionPolicy(EvictSieve))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+	lru.Get("b")
+	lru.Put("d", 4)
+
+	// The hand rests upon c, which is then deleted
+	lru.Del("c")
+	lru.Put("e", 5)
+	lru.Put("f", 6)
+
+	if lru.Size() != 3 || !lru.Has("f") {
+		t.Fatalf("Eviction should proceed after deleting the entry under the hand; Have %v", lru.Keys())
+	}
+}
+
+func TestLRUKEviction(t *testing.T) {
+	lru, err := New(3, nil, WithLRUK(2))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Get("a")
+	lru.Put("b", 2)
+	lru.Get("b")
+
+	// A burst of one-off references should not displace entries referenced repeatedly
+	for i := 0; i < 10; i++ {
+		lru.Put(i, i)
+	}
+
+	if !lru.Has("a") || !lru.Has("b") || !lru.Has(9) {
+		t.Fatalf("LRU-K eviction should evict entries bearing fewer than K references first; Have %v", lru.Keys())
+	}
+
+	// Among entries bearing K references, that whose K-th most recent reference is eldest is evicted
+	lru.Get(9)
+	lru.Get("a")
+	lru.Get("a")
+	lru.Put("c", 3)
+
+	if lru.Has("b") || !lru.Has("a") || !lru.Has("c") || !lru.Has(9) {
+		t.Fatalf("LRU-K eviction should evict the entry bearing the eldest K-th reference; Have %v", lru.Keys())
+	}
+}
+
+func TestLRUKHonorsReservations(t *testing.T) {
+	lru, err := New(4, nil, WithLRUK(3), WithPrefixReservation("vip:", 1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("vip:a", 1)
+	for i := 0; i < 20; i++ {
+		lru.Put(i, i)
+		lru.Get(i)
+		lru.Get(i)
+	}
+
+	if !lru.Has("vip:a") || lru.Size() != 4 {
+		t.Fatalf("LRU-K eviction should not encroach upon a reservation; Have %v", lru.Keys())
+	}
+}
@@ -0,0 +1,36 @@
+package tenure
+
+import "sync"
+
+// WithEntryPooling configures the cache to recycle the internal records of removed entries through a
+// sync.Pool, reducing garbage collection churn in workloads that insert and evict at high rates
+// Records are recycled only once every internal use has ended; keys and values are never pooled, and
+// remain the caller's to retain, e.g. from the eviction callback
+func WithEntryPooling() Option {
+	return func(lc *LRUCache) {
+		lc.pool = &sync.Pool{New: func() interface{} { return new(pair) }}
+	}
+}
+
+/* Utilities */
+
+// newPair returns a blank entry record, recycled where pooling is enabled
+func (lc *LRUCache) newPair() *pair {
+	if lc.pool == nil {
+		return new(pair)
+	}
+
+	return lc.pool.Get().(*pair)
+}
+
+// release recycles the record of a removed entry, where pooling is enabled; the record must be unreachable
+// from the cache's structures, and must not be used thereafter
+// The generation survives recycling, so that revisions of the record's former entry never match its next
+func (lc *LRUCache) release(p *pair) {
+	if lc.pool == nil {
+		return
+	}
+
+	*p = pair{gen: p.gen}
+	lc.pool.Put(p)
+}
@@ -0,0 +1,72 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestEntryPooling(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	var evicted []interface{}
+	lru, err := New(8, func(key, value interface{}) { evicted = append(evicted, value) }, WithClock(clock), WithEntryPooling())
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		switch i % 4 {
+		case 0:
+			lru.PutWithTTL(i, i, 0, time.Second)
+		case 1:
+			lru.Del(i - 1)
+		default:
+			lru.Put(i, i)
+		}
+
+		if i%100 == 0 {
+			clock.Advance(time.Minute)
+		}
+	}
+
+	for i := 992; i < 1000; i++ {
+		if v, ok := lru.Get(i); ok && v != i {
+			t.Fatalf("Recycled entries should not leak values; Have %v, Want %v", v, i)
+		}
+	}
+
+	for i, v := range evicted {
+		if v == nil {
+			t.Fatalf("Eviction callbacks should receive the evicted value; Have <nil> at %v", i)
+		}
+	}
+
+	checkExpiries(t, lru)
+}
+
+func TestEntryPoolingRevisions(t *testing.T) {
+	lru, err := New(8, nil, WithEntryPooling())
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	_, rev, _ := lru.lookup("a")
+
+	p := lru.cache["a"]
+	lru.Del("a")
+
+	if p.key != nil || p.value != nil || p.gen != rev.gen {
+		t.Fatalf("Released records should be cleared, retaining their generation; Have (%v, %v, gen=%v)", p.key, p.value, p.gen)
+	}
+
+	// The record may well be recycled for the fresh entry
+	lru.Put("a", 2)
+
+	spec := entrySpec{ifCurrent: &rev}
+	if lru.insert("a", 3, spec); lru.Peek("a") != 2 {
+		t.Fatal("Revisions of a recycled record's former entry should not match its next")
+	}
+}
@@ -0,0 +1,297 @@
+package tenure
+
+import "math/rand"
+
+// EvictionCandidates returns the keys of the entries the eviction policy would evict, in order of their eviction,
+// were `n` entries inserted under new keys now, such that state dependent upon them may be flushed, or their
+// successors warmed, before the evictions come to pass. The cache itself is left as is
+// The inserted entries are taken to bear neither priority, reservation nor namespace, and the cache's capacity
+// alone is accounted for: evictions per a memory budget, maximum total cost or namespace quota are not foreseen.
+// Under random eviction the candidates are as arbitrary as the policy's own choice, and so merely indicative
+// EvictionCandidates holds the read lock alone, though for time proportional to `n` and the size of the cache
+func (lc *LRUCache) EvictionCandidates(n int) []interface{} {
+	lc.debug.reentry(lc, "EvictionCandidates", nil)
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	pv := lc.preview()
+	for i := 0; i < n && pv.extant > 0; i++ {
+		pv.insert()
+
+		if pv.size <= lc.capacity {
+			continue
+		}
+
+		for low := lc.lowWatermark(); pv.size > low; {
+			front := pv.front()
+			v := pv.victim(front)
+			if v < 0 || v == front {
+				break
+			}
+
+			pv.evict(v)
+		}
+	}
+
+	return pv.evicted
+}
+
+/* Utilities */
+
+// preview simulates the eviction policy of a cache upon a copy of the state it consults, such that the entries it
+// would evict may be foreseen; see EvictionCandidates
+type preview struct {
+	lc *LRUCache
+	// candidates are the entries of the cache, in the order of the recency list, eldest first, followed by those
+	// inserted in simulation
+	candidates []candidate
+	// counts are the simulated entry counts of each reservation
+	counts map[*reservation]int
+	// hand is the index of the candidate under the SIEVE hand, or -1; head that of the eldest candidate extant
+	hand, head int
+	// size is the number of candidates extant, and extant those of which are entries of the cache
+	size, extant int
+	evicted      []interface{}
+	ticks        uint64
+}
+
+// candidate is the simulated state of an entry
+type candidate struct {
+	key       interface{}
+	priority  int
+	class     *reservation
+	visited   bool
+	kth, last uint64
+	inserted  bool
+	evicted   bool
+}
+
+// preview captures the state consulted by the eviction policy; the read lock must be held
+func (lc *LRUCache) preview() *preview {
+	pv := &preview{
+		lc:         lc,
+		candidates: make([]candidate, 0, lc.links.Len()),
+		hand:       -1,
+		ticks:      lc.ticks,
+	}
+
+	if len(lc.reservations) > 0 {
+		pv.counts = make(map[*reservation]int, len(lc.reservations))
+	}
+
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		c := candidate{key: kv.key, priority: kv.priority, class: kv.class, visited: kv.visited, kth: kv.kth}
+		if len(kv.history) > 0 {
+			c.last = kv.history[0]
+		}
+
+		if c.class != nil {
+			pv.counts[c.class] = c.class.count
+		}
+
+		if kv == lc.hand {
+			pv.hand = len(pv.candidates)
+		}
+		pv.candidates = append(pv.candidates, c)
+	}
+	pv.size, pv.extant = len(pv.candidates), len(pv.candidates)
+
+	return pv
+}
+
+// insert simulates the insertion of an entry under a new key
+func (pv *preview) insert() {
+	pv.ticks++
+	pv.candidates = append(pv.candidates, candidate{inserted: true, last: pv.ticks})
+	pv.size++
+}
+
+// evict simulates the eviction of the candidate at index `i`
+func (pv *preview) evict(i int) {
+	c := &pv.candidates[i]
+	c.evicted = true
+	pv.size--
+
+	if c.class != nil {
+		pv.counts[c.class]--
+	}
+
+	if !c.inserted {
+		pv.evicted = append(pv.evicted, c.key)
+		pv.extant--
+	}
+
+	if pv.hand == i {
+		pv.hand = pv.next(i + 1)
+	}
+}
+
+// victim selects the index of the candidate the eviction policy would evict, as does (*LRUCache).victim, or -1
+func (pv *preview) victim(front int) int {
+	switch pv.lc.policy {
+	case EvictRandom:
+		return pv.randomVictim(front)
+	case EvictSieve:
+		return pv.sieveVictim(front)
+	case EvictLRUK:
+		return pv.lrukVictim(front)
+	}
+
+	if i := pv.bandVictim(front); i >= 0 {
+		return i
+	}
+
+	if len(pv.lc.reservations) > 0 {
+		for i := pv.next(0); i >= 0; i = pv.next(i + 1) {
+			if pv.eligible(i, -1) {
+				return i
+			}
+		}
+	}
+
+	return pv.next(0)
+}
+
+// bandVictim selects the eldest eligible candidate of the lowest priority, as does (*LRUCache).bandVictim, or
+// -1 if none, or should every candidate bear priority zero
+func (pv *preview) bandVictim(front int) int {
+	low, banded, zero := 0, false, false
+	for i := pv.next(0); i >= 0; i = pv.next(i + 1) {
+		p := pv.candidates[i].priority
+		if p == 0 {
+			zero = true
+		} else if !banded || p < low {
+			low, banded = p, true
+		}
+	}
+
+	if !banded {
+		return -1
+	}
+	if zero && low > 0 {
+		low = 0
+	}
+
+	best := -1
+	for i := pv.next(0); i >= 0; i = pv.next(i + 1) {
+		if !pv.eligible(i, front) {
+			continue
+		}
+
+		if p := pv.candidates[i].priority; best < 0 || p < pv.candidates[best].priority {
+			if best = i; p == low {
+				break
+			}
+		}
+	}
+
+	return best
+}
+
+// randomVictim selects an eligible candidate uniformly at random, falling back to the eldest
+func (pv *preview) randomVictim(front int) int {
+	chosen, seen := -1, 0
+	for i := pv.next(0); i >= 0; i = pv.next(i + 1) {
+		if pv.eligible(i, front) {
+			if seen++; rand.Intn(seen) == 0 {
+				chosen = i
+			}
+		}
+	}
+
+	if chosen < 0 {
+		return pv.next(0)
+	}
+
+	return chosen
+}
+
+// sieveVictim advances the simulated hand as does (*LRUCache).sieveVictim
+func (pv *preview) sieveVictim(front int) int {
+	i := pv.hand
+	for n := 0; n < 2*pv.size; n++ {
+		if i < 0 {
+			i = pv.next(0)
+		}
+
+		if c := &pv.candidates[i]; c.visited {
+			c.visited = false
+		} else if pv.eligible(i, front) {
+			pv.hand = i
+			return i
+		}
+
+		i = pv.next(i + 1)
+	}
+
+	return pv.next(0)
+}
+
+// lrukVictim selects the eligible candidate bearing the eldest k-th most recent reference, falling back to the
+// eldest candidate
+func (pv *preview) lrukVictim(front int) int {
+	best := -1
+	for i := pv.next(0); i >= 0; i = pv.next(i + 1) {
+		if !pv.eligible(i, front) {
+			continue
+		}
+
+		if best < 0 || pv.referencedBefore(i, best) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		return pv.next(0)
+	}
+
+	return best
+}
+
+// referencedBefore reports whether the candidate at index `i` is due eviction ahead of that at `j` under LRU-K
+// eviction, as does the package-level referencedBefore
+func (pv *preview) referencedBefore(i, j int) bool {
+	a, b := &pv.candidates[i], &pv.candidates[j]
+	if a.kth != b.kth {
+		return a.kth < b.kth
+	}
+
+	return a.last < b.last
+}
+
+// eligible reports whether the candidate at index `i`, other than that at `front`, may be evicted without
+// encroaching upon a reservation's minimum share
+func (pv *preview) eligible(i, front int) bool {
+	c := &pv.candidates[i]
+	return i != front && (c.class == nil || pv.counts[c.class] > c.class.slots)
+}
+
+// next returns the index of the eldest extant candidate at or past index `i`, or -1 if none
+func (pv *preview) next(i int) int {
+	if i <= pv.head {
+		for pv.head < len(pv.candidates) && pv.candidates[pv.head].evicted {
+			pv.head++
+		}
+		i = pv.head
+	}
+
+	for ; i < len(pv.candidates); i++ {
+		if !pv.candidates[i].evicted {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// front returns the index of the newest extant candidate, or -1 if none
+func (pv *preview) front() int {
+	for i := len(pv.candidates) - 1; i >= 0; i-- {
+		if !pv.candidates[i].evicted {
+			return i
+		}
+	}
+
+	return -1
+}
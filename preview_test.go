@@ -0,0 +1,87 @@
+package tenure
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestEvictionCandidates(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{"LRU", nil},
+		{"FIFO", []Option{WithEvictionPolicy(EvictFIFO)}},
+		{"SIEVE", []Option{WithEvictionPolicy(EvictSieve)}},
+		{"LRU-K", []Option{WithLRUK(2)}},
+		{"Reservation", []Option{WithPrefixReservation("vip:", 2)}},
+		{"LowWatermark", []Option{WithLowWatermark(0.5)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []interface{}
+			lru, err := New(8, func(key, value interface{}) {
+				evicted = append(evicted, key)
+			}, tt.opts...)
+			if err != nil {
+				t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+			}
+
+			lru.Put("vip:a", 0)
+			lru.Put("vip:b", 0)
+			for i := 0; i < 6; i++ {
+				lru.Put(i, i)
+			}
+			lru.Get(0)
+			lru.Get(3)
+			lru.Get(3)
+			lru.PutWithPriority(4, 4, 1)
+
+			keys := lru.Keys()
+			candidates := lru.EvictionCandidates(5)
+			if !reflect.DeepEqual(lru.Keys(), keys) {
+				t.Fatalf("EvictionCandidates should leave the cache as is; Have %v, Want %v", lru.Keys(), keys)
+			}
+
+			for i := 0; i < 5; i++ {
+				lru.Put(fmt.Sprintf("new:%v", i), i)
+			}
+
+			if !reflect.DeepEqual(candidates, evicted) {
+				t.Errorf("Candidates mismatch; Have %v, Want %v", candidates, evicted)
+			}
+		})
+	}
+}
+
+func TestEvictionCandidatesRandom(t *testing.T) {
+	lru, _ := New(4, nil, WithEvictionPolicy(EvictRandom))
+	for i := 0; i < 4; i++ {
+		lru.Put(i, i)
+	}
+
+	// The second insertion may evict the first in place of an extant entry, and so foresee a single candidate
+	candidates := lru.EvictionCandidates(2)
+	if len(candidates) < 1 || len(candidates) > 2 || (len(candidates) == 2 && candidates[0] == candidates[1]) {
+		t.Errorf("Candidates should number the evictions of extant entries due; Have %v", candidates)
+	}
+
+	if n := len(lru.EvictionCandidates(100)); n != 4 {
+		t.Errorf("Candidates should be bounded by the size of the cache; Have %v, Want %v", n, 4)
+	}
+}
+
+func TestEvictionCandidatesBelowCapacity(t *testing.T) {
+	lru, _ := New(4, nil)
+	lru.Put("a", 1)
+
+	if candidates := lru.EvictionCandidates(3); len(candidates) != 0 {
+		t.Errorf("No candidates are due while the inserts fit; Have %v", candidates)
+	}
+
+	if candidates := lru.EvictionCandidates(4); !reflect.DeepEqual(candidates, []interface{}{"a"}) {
+		t.Errorf("Candidates mismatch; Have %v, Want %v", candidates, []interface{}{"a"})
+	}
+}
@@ -0,0 +1,88 @@
+package tenure
+
+// PutWithPriority adds or inserts a given key / value pair into the cache, as does Put, at the given priority
+// Entries of a lower priority are always evicted before those of a higher priority, and entries of equal priority
+// in the order of the cache's eviction policy; entries put without a priority bear priority zero
+// Priorities are honored under LRU and FIFO eviction, and disregarded under other policies; see WithEvictionPolicy
+func (lc *LRUCache) PutWithPriority(key, value interface{}, priority int) (wasEvicted bool) {
+	wasEvicted, _ = lc.TryPut(key, value, Priority(priority))
+	return
+}
+
+// Priority sets the entry's eviction priority; see PutWithPriority
+func Priority(priority int) EntryOption {
+	return func(spec *entrySpec) {
+		spec.priority = priority
+	}
+}
+
+/* Utilities */
+
+// reprioritize sets the priority of `p`, accounting for the entries of each priority other than zero
+// The write lock must be held
+func (lc *LRUCache) reprioritize(p *pair, priority int) {
+	if p.priority == priority {
+		return
+	}
+
+	if p.priority != 0 {
+		if lc.bands[p.priority]--; lc.bands[p.priority] == 0 {
+			delete(lc.bands, p.priority)
+		}
+	}
+
+	if priority != 0 {
+		if lc.bands == nil {
+			lc.bands = make(map[int]int)
+		}
+		lc.bands[priority]++
+	}
+
+	p.priority = priority
+}
+
+// lowestBand returns the lowest priority borne by any entry; the write lock must be held
+func (lc *LRUCache) lowestBand() int {
+	low, zero := 0, lc.links.Len()
+	for priority, n := range lc.bands {
+		zero -= n
+		if priority < low {
+			low = priority
+		}
+	}
+
+	// Absent entries of priority zero, the lowest band is the least priority accounted for, though positive
+	if zero == 0 && low == 0 {
+		low = int(^uint(0) >> 1)
+		for priority := range lc.bands {
+			if priority < low {
+				low = priority
+			}
+		}
+	}
+
+	return low
+}
+
+// bandVictim selects the least recently-used entry of the lowest priority, other than the most recently-used,
+// that may be evicted without encroaching upon a reservation's minimum share, or nil if there is none
+// Where the lowest priority holds no such entry, the least recently-used entry of the lowest priority that does
+// is selected
+func (lc *LRUCache) bandVictim() *pair {
+	front, low := lc.links.Front(), lc.lowestBand()
+
+	var best *pair
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		if r := kv.class; kv == front || r != nil && r.count <= r.slots {
+			continue
+		}
+
+		if best == nil || kv.priority < best.priority {
+			if best = kv; kv.priority == low {
+				break
+			}
+		}
+	}
+
+	return best
+}
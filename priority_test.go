@@ -0,0 +1,72 @@
+package tenure
+
+import "testing"
+
+func TestPriorityEviction(t *testing.T) {
+	lru, err := New(3, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithPriority("high", 1, 1)
+	lru.Put("a", 2)
+	lru.PutWithPriority("low", 3, -1)
+	lru.Get("low")
+
+	// The low-priority entry is evicted first, though it is the most recently-used
+	lru.Put("b", 4)
+	if lru.Has("low") || !lru.Has("high") || !lru.Has("a") {
+		t.Fatalf("Entries of a lower priority should be evicted first; Have %v", lru.Keys())
+	}
+
+	// Within a band, the least recently-used entry is evicted
+	lru.Put("c", 5)
+	if lru.Has("a") || !lru.Has("b") || !lru.Has("high") {
+		t.Fatalf("Entries of equal priority should be evicted least recently-used first; Have %v", lru.Keys())
+	}
+
+	lru.Put("d", 6)
+	if !lru.Has("high") {
+		t.Fatalf("Entries of a higher priority should survive while lower ones remain; Have %v", lru.Keys())
+	}
+
+	if info, _ := lru.Inspect("high"); info.Priority != 1 {
+		t.Errorf("Priority mismatch; Have %v, Want %v", info.Priority, 1)
+	}
+}
+
+func TestPriorityResetByPut(t *testing.T) {
+	lru, err := New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithPriority("a", 1, 5)
+	lru.Put("b", 2)
+	lru.Put("a", 1)
+	lru.Get("b")
+	lru.Put("c", 3)
+
+	if lru.Has("a") || !lru.Has("b") {
+		t.Fatalf("A plain Put should reset the priority, restoring LRU eviction; Have %v", lru.Keys())
+	}
+
+	if len(lru.bands) != 0 {
+		t.Errorf("Priority bands should be empty once no entry bears a priority; Have %v", lru.bands)
+	}
+}
+
+func TestPriorityOnlyBands(t *testing.T) {
+	lru, err := New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithPriority("a", 1, 3)
+	lru.PutWithPriority("b", 2, 2)
+	lru.PutWithPriority("c", 3, 3)
+
+	if lru.Has("b") || !lru.Has("a") || !lru.Has("c") {
+		t.Fatalf("The lowest priority should be evicted absent entries of priority zero; Have %v", lru.Keys())
+	}
+}
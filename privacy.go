@@ -0,0 +1,154 @@
+package tenure
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrPrivacyBudget is returned for private statistics requested once the cache's privacy budget is spent
+var ErrPrivacyBudget = errors.New("privacy budget of the statistics is spent")
+
+// aggregateCounts is the number of counts borne by AggregateStats, among which each release's loss is divided
+const aggregateCounts = 5
+
+// AggregateStats are usage statistics with Laplace noise added to every count, so that dashboards may be shared
+// broadly without leaking tenant identifiers or precise per-tenant activity; see WithPrivateStats
+// No keys are ever included
+type AggregateStats struct {
+	Hits      float64
+	Misses    float64
+	Evictions float64
+	Size      float64
+	// Partitions counts the namespaces, or partitions of a PartitionedCache, holding entries
+	Partitions float64
+}
+
+// PrivacyConfig configures the release of private statistics; see WithPrivateStats
+type PrivacyConfig struct {
+	// Epsilon is the privacy loss of a single release, divided evenly among its counts; defaults to 1
+	Epsilon float64
+	// Sensitivity bounds the contribution of any one tenant to any single count, to which the noise is scaled
+	// The cache cannot bound a tenant's activity itself: it must be enforced by the caller, e.g. by rate-limiting
+	// tenants, lest the guarantee not hold. Defaults to 1
+	Sensitivity float64
+	// Budget bounds the total privacy loss of all releases, whose losses add up; once spent, no further release
+	// is made, and PrivateStats returns ErrPrivacyBudget. Defaults to ten times Epsilon
+	Budget float64
+	// Period is the interval for which a release is reused: statistics requested within a period receive the
+	// same noised counts, which so cannot be averaged away, and spend no further budget. Defaults to one minute
+	Period time.Duration
+}
+
+// WithPrivateStats configures the cache to release ε-differentially private statistics via PrivateStats, per
+// `cfg`: each release adds Laplace noise of scale Sensitivity / (Epsilon / 5) to each of its five counts,
+// such that the release as a whole incurs a privacy loss of Epsilon, provided that no tenant contributes more
+// than Sensitivity to any count. A release is reused throughout its Period, and releases cease once their total
+// loss would exceed the Budget
+func WithPrivateStats(cfg PrivacyConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.Epsilon <= 0 {
+			cfg.Epsilon = 1
+		}
+
+		if cfg.Sensitivity <= 0 {
+			cfg.Sensitivity = 1
+		}
+
+		if cfg.Budget <= 0 {
+			cfg.Budget = 10 * cfg.Epsilon
+		}
+
+		if cfg.Period <= 0 {
+			cfg.Period = time.Minute
+		}
+
+		lc.privacy = &privacy{cfg: cfg}
+	}
+}
+
+// PrivateStats returns the cache's usage statistics, as released for the current period; see WithPrivateStats
+// An error is returned if the cache is not so configured, or if its privacy budget is spent
+func (lc *LRUCache) PrivateStats() (AggregateStats, error) {
+	p := lc.privacy
+	if p == nil {
+		return AggregateStats{}, errors.New("the cache is not configured with private statistics")
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := lc.clock.Now()
+	if p.releases > 0 && now.Sub(p.releasedAt) < p.cfg.Period {
+		return p.last, nil
+	}
+
+	if float64(p.releases+1)*p.cfg.Epsilon > p.cfg.Budget*(1+1e-9) {
+		return AggregateStats{}, ErrPrivacyBudget
+	}
+
+	s := lc.Stats()
+	scale := p.cfg.Sensitivity * aggregateCounts / p.cfg.Epsilon
+
+	p.last = AggregateStats{
+		Hits:       noisy(float64(s.Hits), scale),
+		Misses:     noisy(float64(s.Misses), scale),
+		Evictions:  noisy(float64(s.Evictions), scale),
+		Size:       noisy(float64(s.ListLen), scale),
+		Partitions: noisy(float64(lc.occupiedNamespaces()), scale),
+	}
+	p.releases++
+	p.releasedAt = now
+
+	return p.last, nil
+}
+
+// PrivateStats returns usage statistics aggregated across all partitions, as released by the underlying cache
+// per its configuration; see (*LRUCache).PrivateStats. Neither partition identifiers nor per-partition counts
+// are disclosed
+func (pc *PartitionedCache) PrivateStats() (AggregateStats, error) {
+	return pc.lru.PrivateStats()
+}
+
+/* Utilities */
+
+// privacy is the state of a cache's private statistics: the last release, and the number made
+type privacy struct {
+	cfg        PrivacyConfig
+	lock       sync.Mutex
+	last       AggregateStats
+	releases   int
+	releasedAt time.Time
+}
+
+// occupiedNamespaces returns the number of namespaces, or partitions, holding entries
+func (lc *LRUCache) occupiedNamespaces() (n int) {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	for _, st := range lc.namespaces {
+		if st.entries > 0 {
+			n++
+		}
+	}
+
+	return
+}
+
+// noisy adds Laplace noise of the given scale to `n`, clamping the result at zero
+// The noise is drawn from crypto/rand, such that it cannot be predicted from the time of its seeding
+func noisy(n, scale float64) float64 {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+
+	// u is uniform over (-0.5, 0.5), excluding -0.5, at which the logarithm diverges
+	u := (float64(binary.BigEndian.Uint64(b[:])>>11)+0.5)/(1<<53) - 0.5
+	noise := -math.Copysign(scale, u) * math.Log(1-2*math.Abs(u))
+
+	return math.Max(0, math.Round(n+noise))
+}
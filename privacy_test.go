@@ -0,0 +1,68 @@
+package tenure
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestPrivateStatsReleases(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	pc, err := NewPartitioned(1024, nil, WithClock(clock), WithPrivateStats(PrivacyConfig{
+		Epsilon:     1,
+		Sensitivity: 1000,
+		Budget:      2,
+		Period:      time.Minute,
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new partitioned cache instance; see %v", err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		pc.Put(i%10, i, i)
+	}
+
+	first, err := pc.PrivateStats()
+	if err != nil {
+		t.Fatalf("Release failure; see %v", err)
+	}
+
+	// Statistics requested within a period cannot be averaged to recover the true counts
+	for i := 0; i < 10; i++ {
+		if s, _ := pc.PrivateStats(); s != first {
+			t.Fatalf("Statistics should be released once per period; Have %v, Want %v", s, first)
+		}
+	}
+
+	clock.Advance(time.Minute)
+	second, err := pc.PrivateStats()
+	if err != nil || second == first {
+		t.Fatalf("Statistics should be released anew each period; Have (%v, %v)", second, err)
+	}
+
+	clock.Advance(time.Minute)
+	if _, err := pc.PrivateStats(); err != ErrPrivacyBudget {
+		t.Fatalf("Releases should cease once the budget is spent; Have %v, Want %v", err, ErrPrivacyBudget)
+	}
+
+	lru, _ := New(1, nil)
+	if _, err := lru.PrivateStats(); err == nil {
+		t.Fatal("Expected an error for a cache not configured with private statistics")
+	}
+}
+
+func TestNoiseIsUnbiased(t *testing.T) {
+	var sum float64
+	trials := 2000
+
+	for i := 0; i < trials; i++ {
+		sum += noisy(1000, 5)
+	}
+
+	if mean := sum / float64(trials); math.Abs(mean-1000) > 1 {
+		t.Fatalf("Noise should be unbiased; Have mean %v, Want ~%v", mean, 1000)
+	}
+}
@@ -0,0 +1,115 @@
+package tenure
+
+// recency is an intrusive doubly linked list of entries, ordered from most to least recently-used
+// Links are embedded in each entry, so that tracking recency costs no allocations beyond the entry itself;
+// as with container/list, a sentinel root entry closes the ring, and each entry records the list it
+// belongs to, so that Next and Prev report the ends of the list as nil
+type recency struct {
+	root pair
+	len  int
+}
+
+// newRecency returns an initialized, empty list
+func newRecency() *recency {
+	return new(recency).Init()
+}
+
+// Init clears the list
+func (l *recency) Init() *recency {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.len = 0
+	return l
+}
+
+// Len returns the number of entries in the list
+func (l *recency) Len() int { return l.len }
+
+// Front returns the most recently-used entry, or nil if the list is empty
+func (l *recency) Front() *pair {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the least recently-used entry, or nil if the list is empty
+func (l *recency) Back() *pair {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// PushFront inserts `p` at the front of the list, returning it
+func (l *recency) PushFront(p *pair) *pair {
+	l.insert(p, &l.root)
+	return p
+}
+
+// MoveToFront moves `p`, which must belong to the list, to the front of the list
+func (l *recency) MoveToFront(p *pair) {
+	if p.list != l || l.root.next == p {
+		return
+	}
+
+	l.unlink(p)
+	l.insert(p, &l.root)
+}
+
+// MoveBefore moves `p` to the position immediately toward the front of `mark`; both must belong to the list
+func (l *recency) MoveBefore(p, mark *pair) {
+	if p.list != l || mark.list != l || p == mark || mark.prev == p {
+		return
+	}
+
+	l.unlink(p)
+	l.insert(p, mark.prev)
+}
+
+// Remove removes `p` from the list, if it belongs thereto
+func (l *recency) Remove(p *pair) {
+	if p.list != l {
+		return
+	}
+
+	l.unlink(p)
+	p.next, p.prev, p.list = nil, nil, nil
+	l.len--
+}
+
+// Next returns the next entry toward the back of the list, or nil
+func (p *pair) Next() *pair {
+	if n := p.next; p.list != nil && n != &p.list.root {
+		return n
+	}
+	return nil
+}
+
+// Prev returns the previous entry toward the front of the list, or nil
+func (p *pair) Prev() *pair {
+	if n := p.prev; p.list != nil && n != &p.list.root {
+		return n
+	}
+	return nil
+}
+
+/* Utilities */
+
+// insert links `p` after `at`
+func (l *recency) insert(p, at *pair) {
+	p.prev = at
+	p.next = at.next
+	p.prev.next = p
+	p.next.prev = p
+	if p.list != l {
+		p.list = l
+		l.len++
+	}
+}
+
+// unlink detaches `p` from its neighbours, leaving its own links and the length intact
+func (l *recency) unlink(p *pair) {
+	p.prev.next = p.next
+	p.next.prev = p.prev
+}
@@ -0,0 +1,86 @@
+package tenure
+
+import "testing"
+
+func checkRecency(t *testing.T, l *recency, want ...interface{}) {
+	t.Helper()
+
+	if l.Len() != len(want) {
+		t.Fatalf("Length mismatch; Have %v, Want %v", l.Len(), len(want))
+	}
+
+	i := 0
+	for p := l.Front(); p != nil; p = p.Next() {
+		if p.key != want[i] {
+			t.Fatalf("Order mismatch at %v; Have %v, Want %v", i, p.key, want[i])
+		}
+		i++
+	}
+
+	for p := l.Back(); p != nil; p = p.Prev() {
+		i--
+		if p.key != want[i] {
+			t.Fatalf("Reverse order mismatch at %v; Have %v, Want %v", i, p.key, want[i])
+		}
+	}
+}
+
+func TestRecency(t *testing.T) {
+	l := newRecency()
+	checkRecency(t, l)
+
+	if l.Front() != nil || l.Back() != nil {
+		t.Fatal("An empty list should have neither front nor back")
+	}
+
+	a, b, c := &pair{key: "a"}, &pair{key: "b"}, &pair{key: "c"}
+	l.PushFront(a)
+	l.PushFront(b)
+	l.PushFront(c)
+	checkRecency(t, l, "c", "b", "a")
+
+	l.MoveToFront(a)
+	checkRecency(t, l, "a", "c", "b")
+
+	l.MoveToFront(a)
+	checkRecency(t, l, "a", "c", "b")
+
+	l.Remove(c)
+	checkRecency(t, l, "a", "b")
+
+	if c.Next() != nil || c.Prev() != nil {
+		t.Fatal("Removed entries should be unlinked")
+	}
+
+	// Entries not in the list are ignored
+	l.Remove(c)
+	l.MoveToFront(c)
+	checkRecency(t, l, "a", "b")
+
+	other := newRecency()
+	other.PushFront(c)
+	l.Remove(c)
+	checkRecency(t, other, "c")
+
+	l.Init()
+	checkRecency(t, l)
+}
+
+func TestRecencyMoveBefore(t *testing.T) {
+	l := newRecency()
+
+	a, b, c := &pair{key: "a"}, &pair{key: "b"}, &pair{key: "c"}
+	l.PushFront(a)
+	l.PushFront(b)
+	l.PushFront(c)
+
+	l.MoveBefore(c, a)
+	checkRecency(t, l, "b", "c", "a")
+
+	l.MoveBefore(c, a)
+	l.MoveBefore(a, a)
+	checkRecency(t, l, "b", "c", "a")
+
+	l.MoveBefore(a, b)
+	checkRecency(t, l, "a", "b", "c")
+}
@@ -0,0 +1,46 @@
+package tenure
+
+import (
+	"fmt"
+	"io"
+)
+
+// Redactor transforms a key / value pair before it is emitted externally by the cache,
+// e.g. to mask personally identifiable information
+type Redactor func(key, value interface{}) (rk, rv interface{})
+
+// WithRedactor configures the cache to pass every key / value pair it emits externally through `r`
+// This applies to all observability channels, i.e. logs, events, admin endpoints and debug dumps,
+// but never to values returned by the cache's own transactions or passed to its callbacks
+func WithRedactor(r Redactor) Option {
+	return func(lc *LRUCache) {
+		lc.redactor = r
+	}
+}
+
+// Redact returns the given key / value pair as it may be emitted externally,
+// i.e. transformed by the cache's Redactor, if configured
+func (lc *LRUCache) Redact(key, value interface{}) (rk, rv interface{}) {
+	if lc.redactor == nil {
+		return key, value
+	}
+
+	return lc.redactor(key, value)
+}
+
+// Dump writes a human-readable listing of the cache's entries to `w` for debugging purposes,
+// ordered from least to most recently-used; each entry is redacted prior to being written
+func (lc *LRUCache) Dump(w io.Writer) error {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		k, v := lc.Redact(kv.key, lc.decoded(kv.value))
+
+		if _, err := fmt.Fprintf(w, "%v=%v\n", k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
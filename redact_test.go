@@ -0,0 +1,31 @@
+package tenure
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRedactedDump(t *testing.T) {
+	lru, err := New(4, nil, WithRedactor(func(k, v interface{}) (interface{}, interface{}) {
+		return k, "[redacted]"
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("alice", "alice@example.com")
+	lru.Put("bob", "bob@example.com")
+
+	var buf bytes.Buffer
+	if err := lru.Dump(&buf); err != nil {
+		t.Fatalf("Dump failure; see %v", err)
+	}
+
+	if want := "alice=[redacted]\nbob=[redacted]\n"; buf.String() != want {
+		t.Fatalf("Dump mismatch; Have %q, Want %q", buf.String(), want)
+	}
+
+	if v, _ := lru.Get("alice"); v != "alice@example.com" {
+		t.Fatalf("Redaction should not apply to transactions; Have %v", v)
+	}
+}
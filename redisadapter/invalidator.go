@@ -0,0 +1,55 @@
+package redisadapter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// PubSub is the subset of Redis pub/sub commands required by the Invalidator
+// Subscribe must invoke `fn` with the payload of every message subsequently published on `channel`
+type PubSub interface {
+	Publish(channel string, payload []byte) error
+	Subscribe(channel string, fn func(payload []byte)) (cancel func(), err error)
+}
+
+// Invalidator implements tenure's Invalidator over Redis pub/sub
+// Keys are transmitted as strings, so replicas receive string keys irrespective of the published key's type
+type Invalidator struct {
+	ps      PubSub
+	channel string
+}
+
+var _ tenure.Invalidator = (*Invalidator)(nil)
+
+// NewInvalidator initializes a new Invalidator exchanging invalidations over the given channel
+func NewInvalidator(ps PubSub, channel string) *Invalidator {
+	return &Invalidator{ps: ps, channel: channel}
+}
+
+type invalidation struct {
+	Origin string `json:"origin"`
+	Key    string `json:"key"`
+}
+
+// Publish publishes the given invalidation
+func (inv *Invalidator) Publish(msg tenure.Invalidation) error {
+	payload, err := json.Marshal(invalidation{Origin: msg.Origin, Key: fmt.Sprint(msg.Key)})
+	if err != nil {
+		return err
+	}
+
+	return inv.ps.Publish(inv.channel, payload)
+}
+
+// Subscribe registers `fn` to receive every subsequently published invalidation
+// Malformed messages are discarded
+func (inv *Invalidator) Subscribe(fn func(msg tenure.Invalidation)) (cancel func(), err error) {
+	return inv.ps.Subscribe(inv.channel, func(payload []byte) {
+		var m invalidation
+		if json.Unmarshal(payload, &m) == nil {
+			fn(tenure.Invalidation{Origin: m.Origin, Key: m.Key})
+		}
+	})
+}
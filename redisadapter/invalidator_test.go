@@ -0,0 +1,63 @@
+package redisadapter
+
+import (
+	"sync"
+	"testing"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+type fakePubSub struct {
+	mu   sync.Mutex
+	subs map[string][]func([]byte)
+}
+
+func (ps *fakePubSub) Publish(channel string, payload []byte) error {
+	ps.mu.Lock()
+	subs := append(([]func([]byte))(nil), ps.subs[channel]...)
+	ps.mu.Unlock()
+
+	for _, fn := range subs {
+		fn(payload)
+	}
+	return nil
+}
+
+func (ps *fakePubSub) Subscribe(channel string, fn func([]byte)) (func(), error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if ps.subs == nil {
+		ps.subs = make(map[string][]func([]byte))
+	}
+	ps.subs[channel] = append(ps.subs[channel], fn)
+
+	return func() {}, nil
+}
+
+func TestInvalidator(t *testing.T) {
+	ps := &fakePubSub{}
+
+	a, err := tenure.New(4, nil, tenure.WithInvalidator(NewInvalidator(ps, "inv"), nil))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer a.Close()
+
+	b, err := tenure.New(4, nil, tenure.WithInvalidator(NewInvalidator(ps, "inv"), nil))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer b.Close()
+
+	a.Put("k", 1)
+	b.Put("k", 2)
+
+	if a.Has("k") {
+		t.Fatal("Replica should have dropped the invalidated key")
+	}
+
+	if !b.Has("k") {
+		t.Fatal("Publishing replica should retain its own write")
+	}
+}
@@ -0,0 +1,232 @@
+// Package redisadapter implements tenure's LRUController against Redis
+// The adapter depends only upon the small Client interface, which any Redis client can satisfy
+// via a thin shim, so that the same code paths may target local or remote caches
+package redisadapter
+
+import (
+	"fmt"
+	"strings"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// Client is the subset of Redis commands required by the adapter
+// Get must report ok=false, rather than an error, for a missing key
+type Client interface {
+	Get(key string) (value []byte, ok bool, err error)
+	Set(key string, value []byte) error
+	Del(keys ...string) (numDeleted int64, err error)
+	Exists(key string) (bool, error)
+	// Scan issues the SCAN command, returning a page of the keys matching the glob-style pattern `match`
+	// and the cursor of the next page, which is zero once the iteration is complete
+	Scan(cursor uint64, match string, count int64) (keys []string, next uint64, err error)
+}
+
+// Codec converts cached values to and from their stored representation
+type Codec interface {
+	Marshal(value interface{}) ([]byte, error)
+	Unmarshal(data []byte) (interface{}, error)
+}
+
+// StringCodec stores strings and byte slices verbatim, and any other value as its default format
+// Values are always decoded as strings
+type StringCodec struct{}
+
+// Marshal encodes the given value
+func (StringCodec) Marshal(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return []byte(fmt.Sprint(v)), nil
+	}
+}
+
+// Unmarshal decodes the given data as a string
+func (StringCodec) Unmarshal(data []byte) (interface{}, error) {
+	return string(data), nil
+}
+
+// Adapter implements tenure's LRUController against Redis
+// Keys are formatted as strings and scoped under a prefix, so that several adapters may share a database;
+// they are returned by Keys as strings, irrespective of the type of the keys put
+// As the LRUController surface does not return errors, failed commands are reported to the error handler
+// and treated as misses. Eviction is enacted by Redis itself per its `maxmemory-policy`
+type Adapter struct {
+	client  Client
+	prefix  string
+	codec   Codec
+	onError func(op string, err error)
+}
+
+var _ tenure.LRUController = (*Adapter)(nil)
+
+// Option configures optional behavior of an Adapter
+type Option func(*Adapter)
+
+// WithCodec configures the adapter to encode values with `c` rather than a StringCodec
+func WithCodec(c Codec) Option {
+	return func(a *Adapter) {
+		a.codec = c
+	}
+}
+
+// WithErrorHandler registers a handler to be invoked with any error encountered while issuing commands
+func WithErrorHandler(fn func(op string, err error)) Option {
+	return func(a *Adapter) {
+		a.onError = fn
+	}
+}
+
+// New initializes a new Adapter over `client`, scoping all keys under `prefix`
+func New(client Client, prefix string, opts ...Option) *Adapter {
+	a := &Adapter{client: client, prefix: prefix, codec: StringCodec{}}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// Get retrieves the value for the given key
+func (a *Adapter) Get(key interface{}) (value interface{}, ok bool) {
+	data, ok, err := a.client.Get(a.key(key))
+	if a.failed("GET", err) || !ok {
+		return nil, false
+	}
+
+	value, err = a.codec.Unmarshal(data)
+	if a.failed("GET", err) {
+		return nil, false
+	}
+
+	return value, true
+}
+
+// Put stores the given key / value pair; evictions enacted by Redis are not observable, so Put always returns false
+func (a *Adapter) Put(key, value interface{}) (wasEvicted bool) {
+	data, err := a.codec.Marshal(value)
+	if a.failed("SET", err) {
+		return false
+	}
+
+	a.failed("SET", a.client.Set(a.key(key), data))
+	return false
+}
+
+// Del deletes the given key, if extant
+func (a *Adapter) Del(key interface{}) (wasDeleted bool) {
+	n, err := a.client.Del(a.key(key))
+	return !a.failed("DEL", err) && n > 0
+}
+
+// Keys returns the keys stored under the adapter's prefix, with the prefix removed
+// Keys are returned as strings, as stored. Keys iterates by way of SCAN, and so does not block Redis, though
+// keys written or deleted during the iteration may or may not be reported
+func (a *Adapter) Keys() []interface{} {
+	keys := []interface{}{}
+	a.scan(func(raw []string) {
+		for _, k := range raw {
+			keys = append(keys, k[len(a.prefix):])
+		}
+	})
+
+	return keys
+}
+
+// Peek retrieves the value for the given key, or nil if not extant
+func (a *Adapter) Peek(key interface{}) (value interface{}) {
+	value, _ = a.Get(key)
+	return
+}
+
+// Has reports whether the given key exists
+func (a *Adapter) Has(key interface{}) (ok bool) {
+	ok, err := a.client.Exists(a.key(key))
+	return !a.failed("EXISTS", err) && ok
+}
+
+// Drop deletes every key stored under the adapter's prefix
+// The keys are collected in full before any is deleted, and so keys written meanwhile may survive the Drop
+func (a *Adapter) Drop() {
+	var keys []string
+	a.scan(func(raw []string) {
+		keys = append(keys, raw...)
+	})
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > scanCount {
+			n = scanCount
+		}
+
+		_, err := a.client.Del(keys[:n]...)
+		a.failed("DEL", err)
+		keys = keys[n:]
+	}
+}
+
+// Purge drops all items, as does Drop
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (a *Adapter) Purge() {
+	a.Drop()
+}
+
+// Size returns the number of keys stored under the adapter's prefix
+func (a *Adapter) Size() int {
+	return len(a.Keys())
+}
+
+// AdjustCapacity is a no-op; the capacity of a Redis server is governed by its `maxmemory` setting
+func (a *Adapter) AdjustCapacity(bufCap int) (numEvicted int) {
+	return 0
+}
+
+/* Utilities */
+
+func (a *Adapter) key(key interface{}) string {
+	return a.prefix + fmt.Sprint(key)
+}
+
+// scanCount is the number of keys requested of each SCAN page
+const scanCount = 256
+
+// scan invokes `fn` with each non-empty page of the keys stored under the adapter's prefix
+func (a *Adapter) scan(fn func(raw []string)) {
+	match := globEscaper.Replace(a.prefix) + "*"
+
+	var cursor uint64
+	for {
+		raw, next, err := a.client.Scan(cursor, match, scanCount)
+		if a.failed("SCAN", err) {
+			return
+		}
+
+		if len(raw) > 0 {
+			fn(raw)
+		}
+
+		if cursor = next; cursor == 0 {
+			return
+		}
+	}
+}
+
+// globEscaper escapes the characters special to Redis' glob-style patterns, such that a prefix is matched verbatim
+var globEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+
+func (a *Adapter) failed(op string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if a.onError != nil {
+		a.onError(op, err)
+	}
+
+	return true
+}
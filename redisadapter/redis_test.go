@@ -0,0 +1,159 @@
+package redisadapter
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"testing"
+)
+
+type fakeClient struct {
+	mu   sync.Mutex
+	data map[string][]byte
+	err  error
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{data: make(map[string][]byte)}
+}
+
+func (fc *fakeClient) Get(key string) ([]byte, bool, error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	if fc.err != nil {
+		return nil, false, fc.err
+	}
+
+	v, ok := fc.data[key]
+	return v, ok, nil
+}
+
+func (fc *fakeClient) Set(key string, value []byte) error {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	fc.data[key] = value
+	return fc.err
+}
+
+func (fc *fakeClient) Del(keys ...string) (n int64, err error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	for _, k := range keys {
+		if _, ok := fc.data[k]; ok {
+			delete(fc.data, k)
+			n++
+		}
+	}
+	return n, fc.err
+}
+
+func (fc *fakeClient) Exists(key string) (bool, error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	_, ok := fc.data[key]
+	return ok, fc.err
+}
+
+// Scan pages through the keys in lexical order, the cursor being the index of the next page's first key
+func (fc *fakeClient) Scan(cursor uint64, match string, count int64) (keys []string, next uint64, err error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	all := make([]string, 0, len(fc.data))
+	for k := range fc.data {
+		all = append(all, k)
+	}
+	sort.Strings(all)
+
+	end := cursor + uint64(count)
+	if end >= uint64(len(all)) {
+		end = uint64(len(all))
+	} else {
+		next = end
+	}
+
+	for _, k := range all[cursor:end] {
+		if ok, _ := path.Match(match, k); ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys, next, fc.err
+}
+
+func TestAdapter(t *testing.T) {
+	fc := newFakeClient()
+	fc.data["other:a"] = []byte("untouched")
+
+	a := New(fc, "app:")
+
+	a.Put("a", "1")
+	a.Put("b", 2)
+
+	if v, ok := a.Get("b"); !ok || v != "2" {
+		t.Fatalf("Read failure; Have (%v, %v), Want (2, true)", v, ok)
+	}
+
+	if a.Size() != 2 || !a.Has("a") {
+		t.Fatalf("Size mismatch; Have %v, Want %v", a.Size(), 2)
+	}
+
+	if !a.Del("a") || a.Has("a") {
+		t.Fatal("Deletion failure")
+	}
+
+	a.Drop()
+	if a.Size() != 0 || fc.data["other:a"] == nil {
+		t.Fatal("Drop should remove only prefixed keys")
+	}
+}
+
+func TestAdapterScansVerbatimPrefix(t *testing.T) {
+	fc := newFakeClient()
+	fc.data["app-x"] = []byte("untouched")
+
+	a := New(fc, "a*[p]?")
+	for i := 0; i < 2*scanCount+1; i++ {
+		a.Put(i, i)
+	}
+
+	keys := a.Keys()
+	if len(keys) != 2*scanCount+1 {
+		t.Fatalf("Keys should page through every prefixed key; Have %v keys, Want %v", len(keys), 2*scanCount+1)
+	}
+
+	for _, k := range keys {
+		if _, ok := k.(string); !ok || fc.data["a*[p]?"+fmt.Sprint(k)] == nil {
+			t.Fatalf("Keys should return the prefixed keys as strings; Have %#v", k)
+		}
+	}
+
+	a.Drop()
+	if a.Size() != 0 || fc.data["app-x"] == nil {
+		t.Fatalf("Drop should match the prefix verbatim; Have %v keys, untouched %v", a.Size(), fc.data["app-x"] != nil)
+	}
+}
+
+func TestAdapterErrors(t *testing.T) {
+	fc := newFakeClient()
+	var ops []string
+
+	a := New(fc, "app:", WithErrorHandler(func(op string, err error) {
+		ops = append(ops, op)
+	}))
+
+	fc.err = errors.New("connection refused")
+
+	if _, ok := a.Get("a"); ok {
+		t.Fatal("Failed commands should be treated as misses")
+	}
+
+	if len(ops) != 1 || ops[0] != "GET" {
+		t.Fatalf("Error handler failure; Have %v", ops)
+	}
+}
@@ -0,0 +1,32 @@
+package tenure
+
+import "time"
+
+// RefreshAheadConfig configures proactive refresh of entries; see WithRefreshAhead
+type RefreshAheadConfig struct {
+	// Interval is the age at which values loaded from the Store become due a refresh; it ought to be shorter
+	// than any TTL, so that hot entries are refreshed before they expire
+	Interval time.Duration
+	// OnError, if set, is invoked with any error surfaced by the Store during a background refresh
+	OnError func(key interface{}, err error)
+}
+
+// WithRefreshAhead configures a cache backed by a Store to refresh entries from the Store in the background
+// once they are due, ahead of their expiry, so that readers of hot entries never pay the cost of a load
+// Values loaded from the Store fall due once Interval has elapsed; entries put via PutWith may set their own
+// interval via RefreshAfter. A due entry is refreshed upon its next access via GetOrLoad (and so Get), which
+// serves the current value meanwhile; entries not accessed once due are left to expire, so that only hot
+// entries incur refreshes. Refreshes share the machinery, and the guarantees, of WithStaleWhileRevalidate
+func WithRefreshAhead(cfg RefreshAheadConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.Interval <= 0 {
+			return
+		}
+
+		r := lc.refresher()
+		r.ahead = cfg.Interval
+		if cfg.OnError != nil {
+			r.onError = cfg.OnError
+		}
+	}
+}
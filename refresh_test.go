@@ -0,0 +1,79 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestRefreshAhead(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	ms := newMapStore()
+	ms.data["a"] = 1
+
+	lru, err := New(4, nil, WithClock(clock), WithStore(ms),
+		WithStaleWhileRevalidate(RevalidateConfig{TTL: time.Hour}),
+		WithRefreshAhead(RefreshAheadConfig{Interval: time.Minute}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	lru.GetOrLoad("a")
+	ms.Write("a", 2)
+
+	clock.Advance(30 * time.Second)
+	lru.GetOrLoad("a")
+	lru.revalidator.wg.Wait()
+
+	if ms.loads != 1 {
+		t.Fatalf("Entries should not be refreshed before they are due; Have %v loads, Want %v loads", ms.loads, 1)
+	}
+
+	clock.Advance(time.Minute)
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 1 {
+		t.Fatalf("Due entries should be served pending their refresh; Have (%v, %v), Want (1, <nil>)", v, err)
+	}
+	lru.revalidator.wg.Wait()
+
+	info, ok := lru.Inspect("a")
+	if !ok || info.Value != 2 || ms.loads != 2 {
+		t.Fatalf("Refresh mismatch; Have (%v, loads=%v), Want (2, loads=2)", info.Value, ms.loads)
+	}
+
+	if want := clock.Now().Add(time.Hour); !info.SoftExpiry.Equal(want) {
+		t.Fatalf("Refreshed entries should be stamped afresh; Have %v, Want %v", info.SoftExpiry, want)
+	}
+}
+
+func TestRefreshAfter(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	ms := newMapStore()
+
+	lru, err := New(4, nil, WithClock(clock), WithStore(ms), WithRefreshAhead(RefreshAheadConfig{Interval: time.Hour}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	lru.PutWith("a", 1, RefreshAfter(time.Second))
+	lru.Put("b", 1)
+	ms.Write("a", 2)
+	ms.Write("b", 2)
+
+	clock.Advance(time.Minute)
+	lru.GetOrLoad("a")
+	lru.GetOrLoad("b")
+	lru.revalidator.wg.Wait()
+
+	if v := lru.Peek("a"); v != 2 {
+		t.Fatalf("Entries should be refreshed per their own interval; Have %v, Want %v", v, 2)
+	}
+
+	if v := lru.Peek("b"); v != 1 {
+		t.Fatalf("Entries put without an interval should not be refreshed; Have %v, Want %v", v, 1)
+	}
+}
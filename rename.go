@@ -0,0 +1,108 @@
+package tenure
+
+import "container/heap"
+
+// Rename moves the entry for `oldKey` to `newKey`, superseding any entry extant for the latter, and returns whether
+// an entry, neither expired nor negative, was extant to be moved. The entry retains its value, TTLs, priority and
+// provenance, and its position in the eviction order, as though it had borne `newKey` all along
+// The move is atomic, such that no operation observes both keys or neither. The value is written to the backing
+// store, if any, under `newKey`, and `oldKey` deleted therefrom, once moved; should the value exceed the maximum
+// entry cost under `newKey`, the entry is not moved
+func (lc *LRUCache) Rename(oldKey, newKey interface{}) bool {
+	return lc.transfer(oldKey, newKey, true)
+}
+
+// Copy stores the entry for `src` under `dst` as well, superseding any entry extant for the latter, and returns
+// whether an entry, neither expired nor negative, was extant to be copied. The copy retains the value, TTLs,
+// priority and provenance of the entry, and is positioned in the eviction order immediately ahead of it, such
+// that the eviction policy evicts the entry for `src` first. The value is written to the backing store, if any,
+// under `dst` once copied
+func (lc *LRUCache) Copy(src, dst interface{}) bool {
+	return lc.transfer(src, dst, false)
+}
+
+/* Utilities */
+
+// transfer copies the entry for the raw key `from` to the raw key `to`, removing the former where `move` is set
+func (lc *LRUCache) transfer(from, to interface{}, move bool) bool {
+	src, dst := lc.mapKey(from), lc.mapKey(to)
+	if lc.keys.token(src) == lc.keys.token(dst) {
+		return lc.Has(from)
+	}
+
+	var value interface{}
+	for {
+		lc.lock.RLock()
+		kv, ok := lc.cache[src]
+		if !ok || kv.expired(lc.clock.Now()) || kv.negative {
+			lc.lock.RUnlock()
+			return false
+		}
+		rev, snap := revision{kv, kv.gen}, *kv
+		lc.lock.RUnlock()
+
+		var err error
+		if value, err = lc.decode(snap.value); err != nil {
+			return false
+		}
+
+		spec := entrySpec{
+			priority:   snap.priority,
+			provenance: snap.provenance,
+			restore:    &timestamps{snap.created, snap.softExpiry, snap.hardExpiry},
+			noEvict:    true,
+		}
+		weight := lc.cost(to, value, spec)
+		if lc.oversized(weight) {
+			return false
+		}
+		class := lc.classify(to)
+
+		lc.acquire(src)
+		if kv, ok := lc.cache[src]; !ok || kv != rev.p || kv.gen != rev.gen {
+			// The entry was written in the interim, and so is read anew
+			lc.lock.Unlock()
+			continue
+		}
+
+		lc.place(dst, snap.value, class, weight, spec)
+		lc.relocate(lc.cache[lc.keys.bind(dst)], rev.p, move)
+		lc.lock.Unlock()
+		break
+	}
+
+	if err := lc.persist(to, value); err == nil && move {
+		lc.unpersist(from)
+	}
+
+	if move {
+		lc.publish(from)
+	}
+	lc.publish(to)
+
+	return true
+}
+
+// relocate positions the entry `p`, newly stamped from `src`, in place of `src` in the eviction order, removing
+// `src` where `move` is set, and enacts the eviction policy thereupon; the write lock must be held
+func (lc *LRUCache) relocate(p, src *pair, move bool) {
+	lc.links.MoveBefore(p, src)
+
+	p.sliding, p.hardTTL, p.delta, p.refreshAt = src.sliding, src.hardTTL, src.delta, src.refreshAt
+	p.visited = src.visited
+	if lc.policy == EvictLRUK {
+		p.history, p.kth = append(p.history[:0], src.history...), src.kth
+		heap.Fix(&lc.histories, p.rank-1)
+	}
+
+	if move {
+		p.serial = src.serial
+
+		lc.purgeLRUItem(src)
+		lc.emit(EventDelete, src)
+		lc.release(src)
+		lc.maybeCompact()
+	}
+
+	lc.enforce(p)
+}
@@ -0,0 +1,63 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestRename(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	lru, _ := New(3, nil, WithClock(clock))
+
+	lru.PutWithTTL("a", 1, 0, time.Minute)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+
+	if !lru.Rename("a", "z") {
+		t.Fatal("Rename of an extant key should succeed")
+	}
+
+	if lru.Has("a") || lru.Peek("z") != 1 {
+		t.Fatalf("Rename should move the entry; Have %v", lru.Keys())
+	}
+
+	if keys := lru.Keys(); keys[0] != "z" {
+		t.Errorf("Rename should retain the entry's position; Have %v", keys)
+	}
+
+	if info, ok := lru.Inspect("z"); !ok || info.HardExpiry != time.Unix(60, 0) {
+		t.Errorf("Rename should retain the entry's TTLs; Have %v", info.HardExpiry)
+	}
+
+	if lru.Rename("a", "y") {
+		t.Error("Rename of an absent key should fail")
+	}
+
+	if !lru.Rename("z", "b") || lru.Size() != 2 || lru.Peek("b") != 1 {
+		t.Errorf("Rename should supersede an extant entry for the new key; Have %v", lru.Keys())
+	}
+}
+
+func TestCopy(t *testing.T) {
+	var evicted []interface{}
+	lru, _ := New(3, func(key, value interface{}) { evicted = append(evicted, key) })
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+
+	if !lru.Copy("a", "z") {
+		t.Fatal("Copy of an extant key should succeed")
+	}
+
+	// The copy is positioned ahead of its source, which is evicted first
+	if lru.Peek("z") != 1 || len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("Copy should store the entry under the new key; Have %v, evicted %v", lru.Keys(), evicted)
+	}
+
+	if keys := lru.Keys(); keys[0] != "z" {
+		t.Errorf("Copy should position the copy ahead of its source; Have %v", keys)
+	}
+}
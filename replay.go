@@ -0,0 +1,80 @@
+package tenure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+)
+
+// ErrBadReplayKey is returned by Replay where an event bears a key that decodes to an incomparable value, such as
+// a JSON array or object, which cannot key a cache entry
+var ErrBadReplayKey = errors.New("replayed key is not comparable")
+
+// Replay reconstructs cache state from an event log recorded via WithChangeStream, e.g. to warm a standby replica
+// Events are applied in order, and evictions recorded in the log are reproduced exactly rather than re-enacted,
+// so the replica holds the same entries as its source; as reads are not logged, recency is reproduced per the
+// order of writes. Should the replayed entries exceed the replica's capacity, the eviction policy is enacted
+// once the log is exhausted. Replayed mutations are neither written to a backing Store nor published
+// to an Invalidator. Entries retain the expiries recorded in the log, and so expire as they would have at the source
+// Keys and values are decoded per the rules of encoding/json, so, for instance, numeric keys are restored as
+// float64; string keys are therefore advised for caches that are to be replayed
+// Keys that decode to arrays or objects, such as those of a cache configured WithKeyHashing or of a
+// PartitionedCache, cannot be restored: Replay stops at the first such event, returning an error wrapping
+// ErrBadReplayKey. Returns the number of events applied
+func (lc *LRUCache) Replay(r io.Reader) (applied int, err error) {
+	dec := json.NewDecoder(r)
+	defer func() {
+		lc.AdjustCapacity(lc.Capacity())
+	}()
+
+	for {
+		var e Event
+		if err = dec.Decode(&e); err == io.EOF {
+			return applied, nil
+		} else if err != nil {
+			return applied, err
+		}
+
+		switch e.Op {
+		case EventPut, EventUpdate, EventDelete, EventEvict, EventExpire:
+			if e.Key != nil && !reflect.TypeOf(e.Key).Comparable() {
+				return applied, fmt.Errorf("event %v: %w", e.Seq, ErrBadReplayKey)
+			}
+		default:
+			continue
+		}
+
+		switch e.Op {
+		case EventPut, EventUpdate:
+			value, err := lc.admit(e.Value)
+			if err != nil {
+				return applied, err
+			}
+
+			spec := entrySpec{provenance: e.Provenance, noEvict: true}
+			if e.SoftExpiry != nil || e.HardExpiry != nil {
+				spec.restore = &timestamps{created: e.Time, softExpiry: timeOf(e.SoftExpiry), hardExpiry: timeOf(e.HardExpiry)}
+			}
+
+			lc.insert(e.Key, value, spec)
+		default:
+			lc.remove(e.Key)
+		}
+
+		applied++
+	}
+}
+
+/* Utilities */
+
+// timeOf returns the time `t` points to, or the zero time where nil
+func timeOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
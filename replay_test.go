@@ -0,0 +1,96 @@
+package tenure
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestReplay(t *testing.T) {
+	var log bytes.Buffer
+
+	primary, err := New(3, nil, WithChangeStream(&log, nil))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	primary.Put("a", "1")
+	primary.Put("b", "2")
+	primary.Put("c", "3")
+	primary.Get("a")
+	primary.Put("d", "4")
+	primary.Del("c")
+	primary.PutWith("e", "5", Provenance([]byte("src")))
+
+	replica, err := New(3, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	n, err := replica.Replay(&log)
+	if err != nil {
+		t.Fatalf("Replay failure; see %v", err)
+	}
+
+	if n != 7 {
+		t.Fatalf("Applied event count mismatch; Have %v, Want %v", n, 7)
+	}
+
+	if !reflect.DeepEqual(replica.Keys(), []interface{}{"a", "d", "e"}) {
+		t.Fatalf("Replayed state mismatch; Have %v", replica.Keys())
+	}
+
+	if info, _ := replica.Inspect("e"); string(info.Provenance) != "src" {
+		t.Fatalf("Replayed provenance mismatch; Have %q", info.Provenance)
+	}
+}
+
+func TestReplayExpiry(t *testing.T) {
+	var log bytes.Buffer
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	primary, _ := New(3, nil, WithClock(clock), WithChangeStream(&log, nil))
+	primary.PutWithTTL("a", "1", time.Second, time.Minute)
+	primary.Put("b", "2")
+
+	replica, _ := New(3, nil, WithClock(clock))
+	if _, err := replica.Replay(&log); err != nil {
+		t.Fatalf("Replay failure; see %v", err)
+	}
+
+	if info, _ := replica.Inspect("a"); !info.HardExpiry.Equal(time.Unix(60, 0)) || !info.SoftExpiry.Equal(time.Unix(1, 0)) {
+		t.Fatalf("Replayed expiries mismatch; Have %v, %v", info.SoftExpiry, info.HardExpiry)
+	}
+
+	clock.Advance(2 * time.Minute)
+	if replica.Has("a") || !replica.Has("b") {
+		t.Errorf("Replayed entries should expire as at the source; Have %v", replica.Keys())
+	}
+}
+
+func TestReplayIncomparableKeys(t *testing.T) {
+	for name, opt := range map[string]Option{
+		"Hashed":     WithKeyHashing([]byte("secret")),
+		"Namespaced": func(lc *LRUCache) {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var log bytes.Buffer
+
+			primary, _ := New(3, nil, opt, WithChangeStream(&log, nil))
+			if name == "Namespaced" {
+				mustNamespace(t, primary, "tenant").Put("a", "1")
+			} else {
+				primary.Put("a", "1")
+			}
+
+			replica, _ := New(3, nil)
+			if _, err := replica.Replay(&log); !errors.Is(err, ErrBadReplayKey) {
+				t.Errorf("Replay of incomparable keys should fail; Have %v, Want %v", err, ErrBadReplayKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package tenure
+
+import "strings"
+
+type reservation struct {
+	tag   string
+	slots int
+	match func(key interface{}) bool
+	count int
+}
+
+// WithReservation reserves a minimum of `slots` entries of the cache's capacity for keys matched by `match`
+// Entries belonging to a reservation are passed over by the eviction policy for as long as the reservation
+// holds no more than its minimum share, so low-volume but critical entries are never fully displaced by
+// high-volume traffic. A key is assigned to the first reservation whose matcher accepts it
+func WithReservation(tag string, slots int, match func(key interface{}) bool) Option {
+	return func(lc *LRUCache) {
+		if slots <= 0 || match == nil {
+			return
+		}
+
+		lc.reservations = append(lc.reservations, &reservation{tag: tag, slots: slots, match: match})
+	}
+}
+
+// WithPrefixReservation reserves a minimum of `slots` entries for string keys bearing the given prefix
+// The prefix doubles as the reservation's tag
+func WithPrefixReservation(prefix string, slots int) Option {
+	return WithReservation(prefix, slots, func(key interface{}) bool {
+		s, ok := key.(string)
+		return ok && strings.HasPrefix(s, prefix)
+	})
+}
+
+// Reservation returns the number of entries currently held by the reservation designated by `tag`,
+// along with its reserved minimum. The boolean flag is false if no such reservation exists
+func (lc *LRUCache) Reservation(tag string) (count int, slots int, ok bool) {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	for _, r := range lc.reservations {
+		if r.tag == tag {
+			return r.count, r.slots, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+/* Utilities */
+
+func (lc *LRUCache) classify(key interface{}) *reservation {
+	for _, r := range lc.reservations {
+		if r.match(key) {
+			return r
+		}
+	}
+
+	return nil
+}
+
+func (lc *LRUCache) reservedSlots() (n int) {
+	for _, r := range lc.reservations {
+		n += r.slots
+	}
+
+	return
+}
+
+// victim selects the least recently-used item, of the lowest priority borne, that may be evicted without
+// encroaching upon a reservation's minimum share; policies other than LRU and FIFO eviction select their own
+// If every candidate is protected, the least recently-used item is selected regardless
+func (lc *LRUCache) victim() *pair {
+	switch lc.policy {
+	case EvictRandom:
+		return lc.randomVictim()
+	case EvictSieve:
+		return lc.sieveVictim()
+	case EvictLRUK:
+		return lc.lrukVictim()
+	}
+
+	if len(lc.bands) > 0 {
+		if kv := lc.bandVictim(); kv != nil {
+			return kv
+		}
+	}
+
+	if len(lc.reservations) == 0 {
+		return lc.links.Back()
+	}
+
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		r := kv.class
+		if r == nil || r.count > r.slots {
+			return kv
+		}
+	}
+
+	return lc.links.Back()
+}
@@ -0,0 +1,67 @@
+package tenure
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPrefixReservation(t *testing.T) {
+	maxcap := 10
+	reserved := 3
+
+	lru, err := New(maxcap, nil, WithPrefixReservation("flag:", reserved))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < reserved; i++ {
+		lru.Put(fmt.Sprintf("flag:%d", i), i)
+	}
+
+	for i := 0; i < maxcap*10; i++ {
+		lru.Put(fmt.Sprintf("traffic:%d", i), i)
+	}
+
+	for i := 0; i < reserved; i++ {
+		if !lru.Has(fmt.Sprintf("flag:%d", i)) {
+			t.Fatalf("Reserved entry flag:%d was displaced", i)
+		}
+	}
+
+	if count, slots, ok := lru.Reservation("flag:"); !ok || count != reserved || slots != reserved {
+		t.Fatalf("Reservation usage mismatch; Have (%v, %v, %v), Want (%v, %v, true)", count, slots, ok, reserved, reserved)
+	}
+
+	if lru.Size() != maxcap {
+		t.Fatalf("Size mismatch; Have %v, Want %v", lru.Size(), maxcap)
+	}
+}
+
+func TestReservationSurplusIsEvictable(t *testing.T) {
+	maxcap := 4
+
+	lru, err := New(maxcap, nil, WithPrefixReservation("auth:", 1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("auth:a", 1)
+	lru.Put("auth:b", 2)
+	lru.Put("x", 3)
+	lru.Put("y", 4)
+	lru.Put("z", 5)
+
+	if lru.Has("auth:a") {
+		t.Fatal("Reserved entries beyond the reserved minimum should remain evictable")
+	}
+
+	if !lru.Has("auth:b") {
+		t.Fatal("Reserved minimum should not be evicted")
+	}
+}
+
+func TestReservationExceedingCapacity(t *testing.T) {
+	if _, err := New(2, nil, WithPrefixReservation("a", 2)); err == nil {
+		t.Fatal("Expected an error when reserving the entire capacity")
+	}
+}
@@ -0,0 +1,390 @@
+// Package resp serves a tenure LRUCache over the Redis serialization protocol (RESP), so that redis-cli and
+// standard Redis clients may address a tenure cache during local development. The GET, SET (with the EX and
+// PX options), DEL, EXPIRE, TTL, KEYS, INFO, PING and QUIT commands are supported; keys are addressed as
+// strings, and values are stored as []byte. TTLs are reckoned by the wall clock
+package resp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// maxBulkSize bounds the size of bulk strings, as does Redis' default proto-max-bulk-len
+const maxBulkSize = 512 << 20
+
+// maxArgs bounds the number of arguments to a single command
+const maxArgs = 1 << 20
+
+// arity is the number of arguments, including the command name, accepted by each command
+// A negative arity denotes a minimum
+var arity = map[string]int{"GET": 2, "SET": -3, "DEL": -2, "EXPIRE": 3, "TTL": 2, "KEYS": 2, "INFO": -1, "PING": -1}
+
+// ErrServerClosed is returned by Serve once the Server has been closed
+var ErrServerClosed = errors.New("resp: server closed")
+
+// Server serves RESP over an LRUCache
+type Server struct {
+	c *tenure.LRUCache
+
+	mu        sync.Mutex
+	listeners map[net.Listener]struct{}
+	conns     map[net.Conn]struct{}
+	closed    bool
+	wg        sync.WaitGroup
+}
+
+// NewServer initializes a new Server over `c`
+func NewServer(c *tenure.LRUCache) *Server {
+	return &Server{c: c, listeners: make(map[net.Listener]struct{}), conns: make(map[net.Conn]struct{})}
+}
+
+// ListenAndServe listens on the TCP network address `addr` and serves connections thereon; see Serve
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(l)
+}
+
+// Serve accepts connections on `l`, serving each in its own goroutine, until the Server is closed
+// Serve always returns a non-nil error; ErrServerClosed once the Server has been closed
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		l.Close()
+		return ErrServerClosed
+	}
+	s.listeners[l] = struct{}{}
+	s.mu.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			delete(s.listeners, l)
+			s.mu.Unlock()
+
+			if closed {
+				return ErrServerClosed
+			}
+			return err
+		}
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return ErrServerClosed
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go s.serve(conn)
+	}
+}
+
+// Close closes every listener and connection, awaiting the return of every connection's handler
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	for l := range s.listeners {
+		l.Close()
+	}
+	for c := range s.conns {
+		c.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return nil
+}
+
+/* Utilities */
+
+func (s *Server) serve(conn net.Conn) {
+	defer func() {
+		conn.Close()
+
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+
+		s.wg.Done()
+	}()
+
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			var perr protocolError
+			if errors.As(err, &perr) {
+				writeError(w, "ERR Protocol error: "+string(perr))
+				w.Flush()
+			}
+			return
+		}
+
+		if len(args) == 0 {
+			continue
+		}
+
+		if strings.ToUpper(args[0]) == "QUIT" {
+			writeSimple(w, "OK")
+			w.Flush()
+			return
+		}
+
+		s.dispatch(args, w)
+
+		if r.Buffered() == 0 {
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Server) dispatch(args []string, w *bufio.Writer) {
+	cmd := strings.ToUpper(args[0])
+
+	n, ok := arity[cmd]
+	if !ok {
+		writeError(w, fmt.Sprintf("ERR unknown command '%s'", args[0]))
+		return
+	}
+
+	if n > 0 && len(args) != n || n < 0 && len(args) < -n {
+		writeError(w, fmt.Sprintf("ERR wrong number of arguments for '%s' command", strings.ToLower(cmd)))
+		return
+	}
+
+	switch cmd {
+	case "PING":
+		if len(args) > 1 {
+			writeBulk(w, []byte(args[1]))
+		} else {
+			writeSimple(w, "PONG")
+		}
+	case "GET":
+		v, ok := s.c.Get(args[1])
+		if !ok {
+			writeNil(w)
+			return
+		}
+		writeBulk(w, toBytes(v))
+	case "SET":
+		s.set(args, w)
+	case "DEL":
+		var deleted int64
+		for _, key := range args[1:] {
+			if s.c.Del(key) {
+				deleted++
+			}
+		}
+		writeInt(w, deleted)
+	case "EXPIRE":
+		secs, err := strconv.ParseInt(args[2], 10, 64)
+		if err != nil {
+			writeError(w, "ERR value is not an integer or out of range")
+			return
+		}
+
+		info, ok := s.c.Inspect(args[1])
+		if !ok {
+			writeInt(w, 0)
+			return
+		}
+
+		if secs <= 0 {
+			s.c.Del(args[1])
+		} else {
+			s.c.PutWith(args[1], info.Value, tenure.TTL(0, time.Duration(secs)*time.Second), tenure.Provenance(info.Provenance))
+		}
+		writeInt(w, 1)
+	case "TTL":
+		ttl, ok := s.c.TTL(args[1])
+		switch {
+		case !ok:
+			writeInt(w, -2)
+		case ttl == tenure.NoExpiry:
+			writeInt(w, -1)
+		default:
+			writeInt(w, int64((ttl+time.Second-1)/time.Second))
+		}
+	case "KEYS":
+		if _, err := path.Match(args[1], ""); err != nil {
+			writeError(w, "ERR invalid pattern")
+			return
+		}
+
+		var matched []string
+		for _, k := range s.c.Keys() {
+			key := fmt.Sprint(k)
+			if ok, _ := path.Match(args[1], key); ok {
+				matched = append(matched, key)
+			}
+		}
+
+		fmt.Fprintf(w, "*%d\r\n", len(matched))
+		for _, key := range matched {
+			writeBulk(w, []byte(key))
+		}
+	case "INFO":
+		st := s.c.Stats()
+
+		var b strings.Builder
+		b.WriteString("# Stats\r\n")
+		fmt.Fprintf(&b, "keyspace_hits:%d\r\n", st.Hits)
+		fmt.Fprintf(&b, "keyspace_misses:%d\r\n", st.Misses)
+		fmt.Fprintf(&b, "evicted_keys:%d\r\n", st.Evictions)
+		b.WriteString("\r\n# Keyspace\r\n")
+		fmt.Fprintf(&b, "keys:%d\r\n", st.ListLen)
+		fmt.Fprintf(&b, "capacity:%d\r\n", st.Capacity)
+
+		writeBulk(w, []byte(b.String()))
+	}
+}
+
+// set executes `SET key value [EX seconds | PX milliseconds]`
+func (s *Server) set(args []string, w *bufio.Writer) {
+	var ttl time.Duration
+
+	for i := 3; i < len(args); i++ {
+		opt := strings.ToUpper(args[i])
+		if opt != "EX" && opt != "PX" || i+1 == len(args) || ttl != 0 {
+			writeError(w, "ERR syntax error")
+			return
+		}
+
+		n, err := strconv.ParseInt(args[i+1], 10, 64)
+		if err != nil || n <= 0 {
+			writeError(w, "ERR invalid expire time in 'set' command")
+			return
+		}
+
+		if ttl = time.Duration(n) * time.Millisecond; opt == "EX" {
+			ttl = time.Duration(n) * time.Second
+		}
+		i++
+	}
+
+	if ttl > 0 {
+		s.c.PutWithTTL(args[1], []byte(args[2]), 0, ttl)
+	} else {
+		s.c.Put(args[1], []byte(args[2]))
+	}
+
+	writeSimple(w, "OK")
+}
+
+type protocolError string
+
+func (e protocolError) Error() string {
+	return string(e)
+}
+
+// readCommand reads a single command, either as a RESP array of bulk strings or as an inline command
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(line, "*") {
+		return strings.Fields(line), nil
+	}
+
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n > maxArgs {
+		return nil, protocolError("invalid multibulk length")
+	}
+
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.HasPrefix(line, "$") {
+			return nil, protocolError(fmt.Sprintf("expected '$', got '%.1s'", line))
+		}
+
+		size, err := strconv.Atoi(line[1:])
+		if err != nil || size < 0 || size > maxBulkSize {
+			return nil, protocolError("invalid bulk length")
+		}
+
+		b := make([]byte, size+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+
+		if string(b[size:]) != "\r\n" {
+			return nil, protocolError("invalid bulk terminator")
+		}
+
+		args = append(args, string(b[:size]))
+	}
+
+	return args, nil
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func writeSimple(w *bufio.Writer, s string) {
+	w.WriteString("+" + s + "\r\n")
+}
+
+func writeError(w *bufio.Writer, s string) {
+	w.WriteString("-" + s + "\r\n")
+}
+
+func writeInt(w *bufio.Writer, n int64) {
+	fmt.Fprintf(w, ":%d\r\n", n)
+}
+
+func writeNil(w *bufio.Writer) {
+	w.WriteString("$-1\r\n")
+}
+
+func writeBulk(w *bufio.Writer, b []byte) {
+	fmt.Fprintf(w, "$%d\r\n", len(b))
+	w.Write(b)
+	w.WriteString("\r\n")
+}
+
+func toBytes(v interface{}) []byte {
+	switch v := v.(type) {
+	case []byte:
+		return v
+	case string:
+		return []byte(v)
+	default:
+		return []byte(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,107 @@
+package resp
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+func dial(t *testing.T, lru *tenure.LRUCache) (net.Conn, *bufio.Reader, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen; see %v", err)
+	}
+
+	s := NewServer(lru)
+	go s.Serve(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("Failed to dial; see %v", err)
+	}
+
+	return conn, bufio.NewReader(conn), func() {
+		conn.Close()
+		s.Close()
+	}
+}
+
+func roundTrip(t *testing.T, conn net.Conn, r *bufio.Reader, req string, lines int) string {
+	if _, err := conn.Write([]byte(req)); err != nil {
+		t.Fatalf("Write failure; see %v", err)
+	}
+
+	var b strings.Builder
+	for i := 0; i < lines; i++ {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("Read failure; see %v", err)
+		}
+		b.WriteString(line)
+	}
+
+	return b.String()
+}
+
+func TestRESPProtocol(t *testing.T) {
+	lru, err := tenure.New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	conn, r, done := dial(t, lru)
+	defer done()
+
+	tests := []struct {
+		req   string
+		lines int
+		want  string
+	}{
+		{"*1\r\n$4\r\nPING\r\n", 1, "+PONG\r\n"},
+		{"*3\r\n$3\r\nSET\r\n$5\r\nuser1\r\n$5\r\nalice\r\n", 1, "+OK\r\n"},
+		{"*2\r\n$3\r\nGET\r\n$5\r\nuser1\r\n", 2, "$5\r\nalice\r\n"},
+		{"GET missing\r\n", 1, "$-1\r\n"},
+		{"TTL user1\r\n", 1, ":-1\r\n"},
+		{"EXPIRE user1 100\r\n", 1, ":1\r\n"},
+		{"TTL user1\r\n", 1, ":100\r\n"},
+		{"GET user1\r\n", 2, "$5\r\nalice\r\n"},
+		{"EXPIRE missing 100\r\n", 1, ":0\r\n"},
+		{"TTL missing\r\n", 1, ":-2\r\n"},
+		{"SET user2 bob EX 60\r\n", 1, "+OK\r\n"},
+		{"SET user3 eve XX\r\n", 1, "-ERR syntax error\r\n"},
+		{"KEYS user*\r\n", 5, "*2\r\n$5\r\nuser1\r\n$5\r\nuser2\r\n"},
+		{"DEL user1 user2 user3\r\n", 1, ":2\r\n"},
+		{"GET\r\n", 1, "-ERR wrong number of arguments for 'get' command\r\n"},
+		{"FLUSHDB\r\n", 1, "-ERR unknown command 'FLUSHDB'\r\n"},
+	}
+
+	for _, tt := range tests {
+		if have := roundTrip(t, conn, r, tt.req, tt.lines); have != tt.want {
+			t.Fatalf("Response mismatch for %q; Have %q, Want %q", tt.req, have, tt.want)
+		}
+	}
+
+	header := roundTrip(t, conn, r, "INFO\r\n", 1)
+	size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "$")))
+	if err != nil {
+		t.Fatalf("INFO should reply with a bulk string; Have %q", header)
+	}
+
+	body := make([]byte, size+2)
+	if _, err := io.ReadFull(r, body); err != nil {
+		t.Fatalf("Read failure; see %v", err)
+	}
+
+	if !strings.Contains(string(body), "keyspace_hits:2\r\n") || !strings.Contains(string(body), "keys:0\r\n") {
+		t.Fatalf("INFO mismatch; Have %q", body)
+	}
+
+	if have := roundTrip(t, conn, r, "QUIT\r\n", 1); have != "+OK\r\n" {
+		t.Fatalf("QUIT mismatch; Have %q", have)
+	}
+}
@@ -0,0 +1,146 @@
+package tenure
+
+import (
+	"sync"
+	"time"
+)
+
+// RevalidateConfig configures stale-while-revalidate serving; see WithStaleWhileRevalidate
+type RevalidateConfig struct {
+	// TTL is the lifetime of values loaded from the Store, after which they are stale
+	TTL time.Duration
+	// MaxStale is the window past TTL during which a stale value may still be served, pending its refresh
+	MaxStale time.Duration
+	// OnError, if set, is invoked with any error surfaced by the Store during a background refresh
+	OnError func(key interface{}, err error)
+}
+
+// WithStaleWhileRevalidate configures a cache backed by a Store to serve stale entries, i.e. those past their
+// soft TTL, immediately from GetOrLoad (and so Get), while refreshing them from the Store in the background
+// Values loaded from the Store are stamped with a soft TTL of TTL and a hard TTL of TTL+MaxStale; entries put
+// with PutWithTTL are revalidated per their own TTLs. An entry past its hard TTL is never served, and is
+// loaded synchronously as upon any other miss. At most one refresh per key is in flight at a time; a refresh
+// that finds the key deleted from the Store removes it, and one that finds it rewritten in the meantime is
+// discarded. Close awaits any refreshes in flight
+func WithStaleWhileRevalidate(cfg RevalidateConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.TTL <= 0 {
+			return
+		}
+
+		if cfg.MaxStale < 0 {
+			cfg.MaxStale = 0
+		}
+
+		r := lc.refresher()
+		r.ttl, r.maxStale = cfg.TTL, cfg.MaxStale
+		if cfg.OnError != nil {
+			r.onError = cfg.OnError
+		}
+	}
+}
+
+/* Utilities */
+
+// revalidator refreshes entries from the Store in the background, both those served stale and those due a
+// refresh ahead of their expiry; see WithStaleWhileRevalidate and WithRefreshAhead
+type revalidator struct {
+	ttl      time.Duration
+	maxStale time.Duration
+	ahead    time.Duration
+	onError  func(key interface{}, err error)
+	lock     sync.Mutex
+	inflight map[interface{}]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+// refresher returns the cache's revalidator, allocating it upon first use; for use by options alone
+func (lc *LRUCache) refresher() *revalidator {
+	if lc.revalidator == nil {
+		lc.revalidator = &revalidator{inflight: make(map[interface{}]struct{})}
+	}
+
+	return lc.revalidator
+}
+
+// spec returns the entry specification for values loaded from the Store
+func (r *revalidator) spec() entrySpec {
+	spec := entrySpec{refresh: r.ahead}
+	if r.ttl > 0 {
+		spec.soft, spec.hard = r.ttl, r.ttl+r.maxStale
+	}
+
+	return spec
+}
+
+// due reports whether an entry ought to be refreshed in the background upon access at `now`
+func (r *revalidator) due(p *pair, now time.Time) bool {
+	return p.stale(now) || !p.refreshAt.IsZero() && !now.Before(p.refreshAt)
+}
+
+// refresh reloads the value for a raw key in the background, unless a refresh of the key is already in flight
+// The refreshed value supersedes the entry only if it remains at revision `rev`
+func (r *revalidator) refresh(lc *LRUCache, key interface{}, rev revision) {
+	mapped := lc.mapKey(key)
+
+	r.lock.Lock()
+	if _, ok := r.inflight[mapped]; ok || r.closed {
+		r.lock.Unlock()
+		return
+	}
+	r.inflight[mapped] = struct{}{}
+	r.wg.Add(1)
+	r.lock.Unlock()
+
+	go func() {
+		defer func() {
+			r.lock.Lock()
+			delete(r.inflight, mapped)
+			r.lock.Unlock()
+			r.wg.Done()
+		}()
+
+		value, err := lc.load(key)
+		switch {
+		case err == ErrNotFound:
+			lc.removeIf(key, rev)
+		case err != nil:
+			if r.onError != nil {
+				r.onError(key, err)
+			}
+		default:
+			if value, err = lc.admit(value); err != nil {
+				return
+			}
+
+			spec := r.spec()
+			spec.ifCurrent = &rev
+			lc.insert(key, value, spec)
+		}
+	}()
+}
+
+func (r *revalidator) close() error {
+	r.lock.Lock()
+	r.closed = true
+	r.lock.Unlock()
+
+	r.wg.Wait()
+	return nil
+}
+
+// removeIf deletes the entry for a raw key from the cache alone, only if it remains at revision `rev`
+func (lc *LRUCache) removeIf(key interface{}, rev revision) {
+	key = lc.mapKey(key)
+
+	lc.acquire(key)
+	defer lc.lock.Unlock()
+
+	if kv, ok := lc.cache[key]; ok && kv == rev.p && kv.gen == rev.gen {
+		lc.purgeLRUItem(kv)
+		lc.emit(EventDelete, kv)
+		lc.release(kv)
+		lc.maybeCompact()
+	}
+}
@@ -0,0 +1,132 @@
+package tenure
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	ms := newMapStore()
+	ms.data["a"] = 1
+
+	lru, err := New(4, nil, WithClock(clock), WithStore(ms), WithStaleWhileRevalidate(RevalidateConfig{TTL: time.Minute, MaxStale: time.Hour}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 1 {
+		t.Fatalf("Read-through failure; Have (%v, %v), Want (1, <nil>)", v, err)
+	}
+
+	ms.Write("a", 2)
+	clock.Advance(2 * time.Minute)
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 1 {
+		t.Fatalf("Stale values should be served pending their refresh; Have (%v, %v), Want (1, <nil>)", v, err)
+	}
+	lru.revalidator.wg.Wait()
+
+	if v, stale, ok := lru.Lookup("a"); !ok || stale || v != 2 {
+		t.Fatalf("Refresh mismatch; Have (%v, stale=%v, ok=%v), Want (2, stale=false, ok=true)", v, stale, ok)
+	}
+
+	if ms.loads != 2 {
+		t.Fatalf("Load count mismatch; Have %v, Want %v", ms.loads, 2)
+	}
+
+	ms.Delete("a")
+	clock.Advance(2 * time.Minute)
+
+	lru.GetOrLoad("a")
+	lru.revalidator.wg.Wait()
+
+	if lru.Has("a") {
+		t.Fatal("A refresh that finds the key deleted should remove it")
+	}
+}
+
+func TestStaleWhileRevalidateMaxStale(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	ms := newMapStore()
+	ms.data["a"] = 1
+
+	lru, err := New(4, nil, WithClock(clock), WithStore(ms), WithStaleWhileRevalidate(RevalidateConfig{TTL: time.Minute, MaxStale: time.Minute}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	lru.GetOrLoad("a")
+	ms.Write("a", 2)
+	clock.Advance(3 * time.Minute)
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 2 {
+		t.Fatalf("Values past the max-stale window should be loaded synchronously; Have (%v, %v), Want (2, <nil>)", v, err)
+	}
+}
+
+func TestStaleWhileRevalidateDiscardsSuperseded(t *testing.T) {
+	ms := newMapStore()
+	lru, _ := New(4, nil, WithStore(ms), WithStaleWhileRevalidate(RevalidateConfig{TTL: time.Minute}))
+	defer lru.Close()
+
+	lru.Put("a", 1)
+	kv := lru.cache["a"]
+	rev := revision{kv, kv.gen}
+
+	lru.Put("a", 2)
+	ms.Write("a", 3)
+	lru.revalidator.refresh(lru, "a", rev)
+	lru.revalidator.wg.Wait()
+
+	if v := lru.Peek("a"); v != 2 {
+		t.Fatalf("Refreshes of superseded entries should be discarded; Have %v, Want %v", v, 2)
+	}
+}
+
+type failingStore struct{ err error }
+
+func (fs failingStore) Load(key interface{}) (interface{}, error) { return nil, fs.err }
+func (fs failingStore) Write(key, value interface{}) error        { return fs.err }
+func (fs failingStore) Delete(key interface{}) error              { return fs.err }
+
+func TestStaleWhileRevalidateErrors(t *testing.T) {
+	if _, err := New(4, nil, WithStaleWhileRevalidate(RevalidateConfig{TTL: time.Minute})); err == nil {
+		t.Fatal("Expected an error absent a Store")
+	}
+
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	unavailable := errors.New("unavailable")
+
+	var failed error
+	lru, err := New(4, nil, WithClock(clock), WithStore(failingStore{unavailable}), WithStaleWhileRevalidate(RevalidateConfig{
+		TTL:      time.Minute,
+		MaxStale: time.Hour,
+		OnError:  func(key interface{}, err error) { failed = err },
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	lru.PutWithTTL("a", 1, time.Minute, time.Hour)
+	clock.Advance(2 * time.Minute)
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 1 {
+		t.Fatalf("Stale values should be served pending their refresh; Have (%v, %v), Want (1, <nil>)", v, err)
+	}
+	lru.revalidator.wg.Wait()
+
+	if failed != unavailable {
+		t.Fatalf("Refresh errors should be reported; Have %v, Want %v", failed, unavailable)
+	}
+
+	if v := lru.Peek("a"); v != 1 {
+		t.Fatalf("Failed refreshes should retain the stale value; Have %v, Want %v", v, 1)
+	}
+}
@@ -0,0 +1,50 @@
+package tenure
+
+import "math/rand"
+
+// RandomKey returns a key chosen uniformly at random from those extant in the cache, without enacting the
+// eviction policy; the boolean flag is false if the cache holds no entry not past its hard expiry
+func (lc *LRUCache) RandomKey() (key interface{}, ok bool) {
+	if sample := lc.Sample(1); len(sample) > 0 {
+		return sample[0].Key, true
+	}
+
+	return nil, false
+}
+
+// Sample returns up to `n` entries chosen uniformly at random, without replacement, from those extant in the
+// cache, and their metadata, as does Inspect; entries past their hard expiry are never chosen, while negative
+// entries are, as reported by EntryInfo. Sampling enacts no eviction policy, and so suits audits of the cache
+// against its source of truth. Sample holds the read lock alone, though for time linear in the size of the cache
+func (lc *LRUCache) Sample(n int) []EntryInfo {
+	if n <= 0 {
+		return nil
+	}
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	now := lc.clock.Now()
+
+	// Reservoir sampling: the i-th live entry displaces a chosen entry with probability n / i
+	chosen := make([]*pair, 0, n)
+	seen := 0
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		if kv.expired(now) {
+			continue
+		}
+
+		if seen++; len(chosen) < n {
+			chosen = append(chosen, kv)
+		} else if j := rand.Intn(seen); j < n {
+			chosen[j] = kv
+		}
+	}
+
+	sample := make([]EntryInfo, len(chosen))
+	for i, kv := range chosen {
+		sample[i] = lc.info(kv)
+	}
+
+	return sample
+}
@@ -0,0 +1,69 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestRandomKey(t *testing.T) {
+	lru, _ := New(10, nil)
+
+	if _, ok := lru.RandomKey(); ok {
+		t.Fatal("RandomKey of an empty cache should report no key")
+	}
+
+	for i := 0; i < 10; i++ {
+		lru.Put(i, i)
+	}
+
+	seen := make(map[interface{}]bool)
+	for i := 0; i < 200; i++ {
+		key, ok := lru.RandomKey()
+		if !ok || !lru.Has(key) {
+			t.Fatalf("RandomKey should return an extant key; Have %v", key)
+		}
+		seen[key] = true
+	}
+
+	if len(seen) != 10 {
+		t.Errorf("RandomKey should eventually return every key; Have %v distinct keys", len(seen))
+	}
+
+	if keys := lru.Keys(); keys[0] != 0 {
+		t.Errorf("RandomKey should not enact the eviction policy; Have %v", keys)
+	}
+}
+
+func TestSample(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	lru, _ := New(10, nil, WithClock(clock))
+
+	lru.PutWithTTL("expired", 0, 0, time.Second)
+	for i := 0; i < 5; i++ {
+		lru.Put(i, i*10)
+	}
+	clock.Advance(2 * time.Second)
+
+	sample := lru.Sample(3)
+	if len(sample) != 3 {
+		t.Fatalf("Sample size mismatch; Have %v, Want %v", len(sample), 3)
+	}
+
+	distinct := make(map[interface{}]bool)
+	for _, e := range sample {
+		if e.Key == "expired" || e.Value != e.Key.(int)*10 {
+			t.Errorf("Sample should return live entries and their values; Have %v: %v", e.Key, e.Value)
+		}
+		distinct[e.Key] = true
+	}
+
+	if len(distinct) != 3 {
+		t.Errorf("Sample should choose entries without replacement; Have %v", sample)
+	}
+
+	if n := len(lru.Sample(10)); n != 5 {
+		t.Errorf("Sample should return at most the live entries; Have %v, Want %v", n, 5)
+	}
+}
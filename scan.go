@@ -0,0 +1,121 @@
+package tenure
+
+import (
+	"path"
+	"sort"
+)
+
+// KeyOrder designates the order in which KeysOrdered returns the keys of the cache
+type KeyOrder int
+
+const (
+	// OrderLeastRecent orders keys from the least recently-used to the most, as does Keys
+	OrderLeastRecent KeyOrder = iota
+	// OrderMostRecent orders keys from the most recently-used to the least
+	OrderMostRecent
+	// OrderInserted orders keys by the insertion of their entries, eldest first; updates do not reorder entries
+	OrderInserted
+)
+
+// KeysOrdered returns the keys extant in the cache, as does Keys, in the given order, such that listings of the
+// cache may be compared verbatim, e.g. in golden tests. Recency is that of the eviction policy: under policies
+// that never reorder entries, such as FIFO eviction, the recency orders are those of insertion
+func (lc *LRUCache) KeysOrdered(order KeyOrder) []interface{} {
+	lc.debug.reentry(lc, "KeysOrdered", nil)
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	keys := make([]interface{}, 0, lc.links.Len())
+
+	switch order {
+	case OrderMostRecent:
+		for kv := lc.links.Front(); kv != nil; kv = kv.Next() {
+			keys = append(keys, kv.key)
+		}
+	case OrderInserted:
+		entries := make([]*pair, 0, lc.links.Len())
+		for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+			entries = append(entries, kv)
+		}
+
+		sort.Slice(entries, func(i, j int) bool { return entries[i].serial < entries[j].serial })
+		for _, kv := range entries {
+			keys = append(keys, kv.key)
+		}
+	default:
+		for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+			keys = append(keys, kv.key)
+		}
+	}
+
+	return keys
+}
+
+// KeysMatching returns the string keys extant in the cache that match the glob `pattern`, per path.Match, in
+// the order of Keys; keys of other types never match. Returns path.ErrBadPattern should the pattern be malformed
+// Keys of a cache configured WithKeyHashing are stored hashed, and so never match
+func (lc *LRUCache) KeysMatching(pattern string) ([]interface{}, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	var keys []interface{}
+	for kv := lc.links.Back(); kv != nil; kv = kv.Prev() {
+		if s, ok := kv.key.(string); ok {
+			if matched, _ := path.Match(pattern, s); matched {
+				keys = append(keys, s)
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// Scan incrementally iterates the keys of the cache, as does Redis SCAN: each call returns up to `count` keys
+// alongside the cursor from which to resume, starting from a cursor of zero, until the cursor returned is zero
+// Keys extant throughout the iteration are returned exactly once, however the cache is mutated meanwhile; keys
+// inserted or removed meanwhile may or may not be returned. Keys are returned in order of their insertion
+// Each call holds the read lock alone, though for time linear in the size of the cache
+func (lc *LRUCache) Scan(cursor uint64, count int) (keys []interface{}, next uint64) {
+	if count <= 0 {
+		count = 10
+	}
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	page := make([]*pair, 0, count+1)
+	for _, kv := range lc.cache {
+		if kv.serial <= cursor {
+			continue
+		}
+
+		if len(page) == count+1 && kv.serial > page[count].serial {
+			continue
+		}
+
+		// Retain the count + 1 eldest entries past the cursor, the last revealing whether any remain
+		i := sort.Search(len(page), func(i int) bool { return page[i].serial > kv.serial })
+		if len(page) < count+1 {
+			page = append(page, nil)
+		}
+		copy(page[i+1:], page[i:])
+		page[i] = kv
+	}
+
+	if len(page) > count {
+		page = page[:count]
+		next = page[count-1].serial
+	}
+
+	keys = make([]interface{}, len(page))
+	for i, kv := range page {
+		keys[i] = kv.key
+	}
+
+	return keys, next
+}
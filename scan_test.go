@@ -0,0 +1,118 @@
+package tenure
+
+import (
+	"path"
+	"reflect"
+	"testing"
+)
+
+func TestKeysMatching(t *testing.T) {
+	lru, err := New(10, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("user:1", 1)
+	lru.Put("user:2", 2)
+	lru.Put("post:1", 3)
+	lru.Put(1, 4)
+
+	keys, err := lru.KeysMatching("user:*")
+	if err != nil {
+		t.Fatalf("Failed to match keys; see %v", err)
+	}
+
+	if len(keys) != 2 || keys[0] != "user:1" || keys[1] != "user:2" {
+		t.Errorf("Matched keys mismatch; Have %v, Want %v", keys, []interface{}{"user:1", "user:2"})
+	}
+
+	if _, err := lru.KeysMatching("[user"); err != path.ErrBadPattern {
+		t.Errorf("Expected path.ErrBadPattern for a malformed pattern; Have %v", err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	lru, err := New(100, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 25; i++ {
+		lru.Put(i, i)
+	}
+
+	seen := make(map[interface{}]int)
+	var cursor uint64
+	for calls := 0; ; calls++ {
+		keys, next := lru.Scan(cursor, 10)
+		for _, k := range keys {
+			seen[k]++
+		}
+
+		// Mutations between calls must not disturb the iteration
+		if calls == 0 {
+			lru.Get(20)
+			lru.Del(3)
+			lru.Put(100, 100)
+		}
+
+		if cursor = next; cursor == 0 {
+			break
+		}
+
+		if calls > 3 {
+			t.Fatal("Scan should terminate")
+		}
+	}
+
+	for i := 0; i < 25; i++ {
+		if i != 3 && seen[i] != 1 {
+			t.Errorf("Key %v should be returned exactly once; Have %v", i, seen[i])
+		}
+	}
+}
+
+func TestScanExactPage(t *testing.T) {
+	lru, err := New(10, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 4; i++ {
+		lru.Put(i, i)
+	}
+
+	keys, next := lru.Scan(0, 4)
+	if len(keys) != 4 || next != 0 {
+		t.Errorf("A page holding every key should end the iteration; Have (keys=%v, next=%v)", keys, next)
+	}
+
+	keys, next = lru.Scan(0, 3)
+	if len(keys) != 3 || keys[0] != 0 || keys[2] != 2 || next == 0 {
+		t.Errorf("Scan should return the eldest keys first; Have (keys=%v, next=%v)", keys, next)
+	}
+}
+
+func TestKeysOrdered(t *testing.T) {
+	lru, _ := New(5, nil)
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+	lru.Get("a")
+	lru.Put("b", 20)
+
+	for order, want := range map[KeyOrder][]interface{}{
+		OrderLeastRecent: {"c", "a", "b"},
+		OrderMostRecent:  {"b", "a", "c"},
+		OrderInserted:    {"a", "b", "c"},
+	} {
+		if keys := lru.KeysOrdered(order); !reflect.DeepEqual(keys, want) {
+			t.Errorf("Keys in order %v mismatch; Have %v, Want %v", order, keys, want)
+		}
+	}
+
+	if keys := lru.Keys(); !reflect.DeepEqual(keys, lru.KeysOrdered(OrderLeastRecent)) {
+		t.Errorf("Keys should order keys from the least recently-used; Have %v", keys)
+	}
+}
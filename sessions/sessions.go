@@ -0,0 +1,175 @@
+// Package sessions keeps web sessions in-process within a tenure cache, bounding their number (and, by way of
+// tenure.WithMemoryBudget, their weight) and expiring them after a period of inactivity. Its Store follows
+// the semantics of gorilla/sessions: sessions are retrieved per request by cookie name, mutated via their
+// Values, and persisted by Save, which also sets the cookie. Only a random session ID is sent to clients
+package sessions
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// Options configures session cookies, after the fashion of gorilla/sessions
+type Options struct {
+	Path   string
+	Domain string
+	// MaxAge is the number of seconds for which the session, and its cookie, live past its latest Save
+	// A negative MaxAge deletes the session upon Save
+	MaxAge   int
+	Secure   bool
+	HttpOnly bool
+	SameSite http.SameSite
+}
+
+// Session is a set of values persisted across requests
+type Session struct {
+	// ID identifies the session; it is assigned upon creation
+	ID string
+	// Values holds the session's data
+	Values map[interface{}]interface{}
+	// Options configures the session's cookie; it is copied from the Store's upon retrieval
+	Options *Options
+	// IsNew is true if the session was created rather than retrieved
+	IsNew bool
+	name  string
+	store *Store
+}
+
+// Name returns the name of the session's cookie
+func (s *Session) Name() string {
+	return s.name
+}
+
+// Save persists the session; see Store.Save
+func (s *Session) Save(r *http.Request, w http.ResponseWriter) error {
+	return s.store.Save(r, w, s)
+}
+
+// Store keeps sessions in an LRUCache; the least recently-used sessions are evicted once it is full
+type Store struct {
+	c *tenure.LRUCache
+	// Options is the default configuration of session cookies
+	Options *Options
+}
+
+// NewStore initializes a new Store holding at most `capacity` sessions, each living for `maxAge` past its
+// latest Save; any trailing options configure the underlying cache
+func NewStore(capacity int, maxAge time.Duration, opts ...tenure.Option) (*Store, error) {
+	if maxAge < time.Second {
+		return nil, errors.New("sessions must live for at least a second")
+	}
+
+	c, err := tenure.New(capacity, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{c: c, Options: &Options{Path: "/", MaxAge: int(maxAge / time.Second), HttpOnly: true}}, nil
+}
+
+// Get returns the session designated by the request's cookie `name`, or a new session if there is none
+func (st *Store) Get(r *http.Request, name string) (*Session, error) {
+	return st.New(r, name)
+}
+
+// New returns the session designated by the request's cookie `name`, or a new session if there is none,
+// or if it has expired or been evicted. The session's values are a copy of those stored
+func (st *Store) New(r *http.Request, name string) (*Session, error) {
+	opts := *st.Options
+	s := &Session{Values: make(map[interface{}]interface{}), Options: &opts, IsNew: true, name: name, store: st}
+
+	c, err := r.Cookie(name)
+	if err != nil {
+		return s, nil
+	}
+
+	v, ok := st.c.Get(c.Value)
+	if !ok {
+		return s, nil
+	}
+
+	for k, v := range v.(map[interface{}]interface{}) {
+		s.Values[k] = v
+	}
+	s.ID, s.IsNew = c.Value, false
+
+	return s, nil
+}
+
+// Save persists the session's values, extending its life by its MaxAge, and sets its cookie
+// If the session's MaxAge is negative, the session is instead deleted, along with its cookie
+func (st *Store) Save(r *http.Request, w http.ResponseWriter, s *Session) error {
+	if s.Options.MaxAge < 0 {
+		if s.ID != "" {
+			st.c.Del(s.ID)
+		}
+
+		http.SetCookie(w, st.cookie(s, ""))
+		return nil
+	}
+
+	if s.ID == "" {
+		id, err := newID()
+		if err != nil {
+			return err
+		}
+		s.ID = id
+	}
+
+	values := make(map[interface{}]interface{}, len(s.Values))
+	for k, v := range s.Values {
+		values[k] = v
+	}
+
+	st.c.PutWithTTL(s.ID, values, 0, time.Duration(s.Options.MaxAge)*time.Second)
+	http.SetCookie(w, st.cookie(s, s.ID))
+
+	return nil
+}
+
+// Len returns the number of sessions held, including any expired but not yet removed
+func (st *Store) Len() int {
+	return st.c.Size()
+}
+
+// Close halts the underlying cache's background workers, if any
+func (st *Store) Close() error {
+	return st.c.Close()
+}
+
+/* Utilities */
+
+func (st *Store) cookie(s *Session, value string) *http.Cookie {
+	c := &http.Cookie{
+		Name:     s.name,
+		Value:    value,
+		Path:     s.Options.Path,
+		Domain:   s.Options.Domain,
+		MaxAge:   s.Options.MaxAge,
+		Secure:   s.Options.Secure,
+		HttpOnly: s.Options.HttpOnly,
+		SameSite: s.Options.SameSite,
+	}
+
+	if s.Options.MaxAge > 0 {
+		c.Expires = time.Now().Add(time.Duration(s.Options.MaxAge) * time.Second)
+	} else if s.Options.MaxAge < 0 {
+		c.Expires = time.Unix(1, 0)
+	}
+
+	return c
+}
+
+func newID() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,126 @@
+package sessions
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func request(cookies ...*http.Cookie) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+	return r
+}
+
+func save(t *testing.T, s *Session) *http.Cookie {
+	w := httptest.NewRecorder()
+	if err := s.Save(request(), w); err != nil {
+		t.Fatalf("Failed to save session; see %v", err)
+	}
+
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("Save should set a cookie; Have %v", cookies)
+	}
+
+	return cookies[0]
+}
+
+func TestSessionLifecycle(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	st, err := NewStore(8, time.Hour, tenure.WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new store; see %v", err)
+	}
+
+	s, _ := st.Get(request(), "sid")
+	if !s.IsNew || s.Name() != "sid" {
+		t.Fatalf("Requests bearing no cookie should receive a new session; Have %+v", s)
+	}
+
+	s.Values["user"] = "alice"
+	c := save(t, s)
+
+	if c.Name != "sid" || c.Value != s.ID || c.MaxAge != 3600 || !c.HttpOnly {
+		t.Fatalf("Cookie mismatch; Have %+v", c)
+	}
+
+	got, _ := st.Get(request(c), "sid")
+	if got.IsNew || got.ID != s.ID || got.Values["user"] != "alice" {
+		t.Fatalf("Saved sessions should be retrieved; Have %+v", got)
+	}
+
+	got.Values["user"] = "mallory"
+	if again, _ := st.Get(request(c), "sid"); again.Values["user"] != "alice" {
+		t.Fatal("Unsaved mutations should not be visible to other requests")
+	}
+
+	clock.Advance(59 * time.Minute)
+	save(t, got)
+	clock.Advance(59 * time.Minute)
+
+	if again, _ := st.Get(request(c), "sid"); again.IsNew || again.Values["user"] != "mallory" {
+		t.Fatal("Saving should extend a session's life")
+	}
+
+	clock.Advance(2 * time.Hour)
+	if again, _ := st.Get(request(c), "sid"); !again.IsNew {
+		t.Fatal("Sessions should expire after their MaxAge")
+	}
+
+	forged, _ := st.Get(request(&http.Cookie{Name: "sid", Value: "forged"}), "sid")
+	if !forged.IsNew || forged.ID != "" {
+		t.Fatal("Unknown session IDs should not be adopted")
+	}
+}
+
+func TestSessionDeletion(t *testing.T) {
+	st, err := NewStore(8, time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new store; see %v", err)
+	}
+
+	s, _ := st.New(request(), "sid")
+	c := save(t, s)
+
+	s.Options.MaxAge = -1
+	if deleted := save(t, s); deleted.MaxAge >= 0 || deleted.Value != "" {
+		t.Fatalf("Deletion should expire the cookie; Have %+v", deleted)
+	}
+
+	if again, _ := st.Get(request(c), "sid"); !again.IsNew || st.Len() != 0 {
+		t.Fatal("Deleted sessions should not be retrieved")
+	}
+}
+
+func TestSessionCapacity(t *testing.T) {
+	st, err := NewStore(2, time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new store; see %v", err)
+	}
+
+	var cookies []*http.Cookie
+	for i := 0; i < 3; i++ {
+		s, _ := st.New(request(), "sid")
+		cookies = append(cookies, save(t, s))
+	}
+
+	if st.Len() != 2 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", st.Len(), 2)
+	}
+
+	if s, _ := st.Get(request(cookies[0]), "sid"); !s.IsNew {
+		t.Fatal("The least recently-used session should be evicted")
+	}
+
+	if _, err := NewStore(2, time.Millisecond); err == nil {
+		t.Fatal("Expected an error for a sub-second lifetime")
+	}
+}
@@ -0,0 +1,110 @@
+// Package sim provides tooling to stress and size caches offline against synthetic or recorded workloads
+package sim
+
+import (
+	"math/rand"
+)
+
+// Generator produces an endless sequence of keys drawn from a synthetic workload
+// Keys are ints in the range [0, cardinality). Generators are not safe for concurrent use
+type Generator interface {
+	Next() interface{}
+}
+
+// Take draws `n` keys from `g`
+func Take(g Generator, n int) []interface{} {
+	keys := make([]interface{}, n)
+	for i := range keys {
+		keys[i] = g.Next()
+	}
+
+	return keys
+}
+
+type uniform struct {
+	rng         *rand.Rand
+	cardinality int
+}
+
+// NewUniform initializes a generator drawing every key with equal probability
+func NewUniform(cardinality int, seed int64) Generator {
+	return &uniform{rng: rand.New(rand.NewSource(seed)), cardinality: cardinality}
+}
+
+func (u *uniform) Next() interface{} {
+	return u.rng.Intn(u.cardinality)
+}
+
+type zipfian struct {
+	z *rand.Zipf
+}
+
+// NewZipfian initializes a generator drawing keys per a Zipf distribution with exponent `s`, which must exceed 1;
+// key 0 is the most popular, and popularity decays with rank. Values of `s` near 1 approximate web-like skew
+func NewZipfian(cardinality int, s float64, seed int64) Generator {
+	if s <= 1 {
+		s = 1.0001
+	}
+
+	return &zipfian{z: rand.NewZipf(rand.New(rand.NewSource(seed)), s, 1, uint64(cardinality-1))}
+}
+
+func (z *zipfian) Next() interface{} {
+	return int(z.z.Uint64())
+}
+
+type hotspot struct {
+	rng         *rand.Rand
+	cardinality int
+	hotset      int
+	hotFraction float64
+	shiftEvery  int
+	offset      int
+	n           int
+}
+
+// NewHotspotShift initializes a generator directing `hotFraction` of accesses at a hot set of `hotset` keys,
+// and the remainder uniformly across all keys; every `shiftEvery` accesses, the hot set moves to fresh keys,
+// modeling workloads whose working set drifts over time. A non-positive `shiftEvery` never shifts
+func NewHotspotShift(cardinality, hotset int, hotFraction float64, shiftEvery int, seed int64) Generator {
+	if hotset > cardinality {
+		hotset = cardinality
+	}
+
+	return &hotspot{
+		rng:         rand.New(rand.NewSource(seed)),
+		cardinality: cardinality,
+		hotset:      hotset,
+		hotFraction: hotFraction,
+		shiftEvery:  shiftEvery,
+	}
+}
+
+func (h *hotspot) Next() interface{} {
+	h.n++
+	if h.shiftEvery > 0 && h.n%h.shiftEvery == 0 {
+		h.offset = (h.offset + h.hotset) % h.cardinality
+	}
+
+	if h.rng.Float64() < h.hotFraction {
+		return (h.offset + h.rng.Intn(h.hotset)) % h.cardinality
+	}
+
+	return h.rng.Intn(h.cardinality)
+}
+
+type scan struct {
+	cardinality int
+	next        int
+}
+
+// NewScan initializes a generator cycling sequentially through every key, the pathological case for LRU
+func NewScan(cardinality int) Generator {
+	return &scan{cardinality: cardinality}
+}
+
+func (s *scan) Next() interface{} {
+	k := s.next
+	s.next = (s.next + 1) % s.cardinality
+	return k
+}
@@ -0,0 +1,45 @@
+package sim
+
+import (
+	"testing"
+)
+
+func TestGeneratorsRespectCardinality(t *testing.T) {
+	cardinality := 100
+
+	generators := map[string]Generator{
+		"uniform": NewUniform(cardinality, 1),
+		"zipfian": NewZipfian(cardinality, 1.1, 1),
+		"hotspot": NewHotspotShift(cardinality, 10, 0.9, 50, 1),
+		"scan":    NewScan(cardinality),
+	}
+
+	for name, g := range generators {
+		for _, k := range Take(g, 10000) {
+			if k.(int) < 0 || k.(int) >= cardinality {
+				t.Fatalf("%v generator exceeded its cardinality; Have %v", name, k)
+			}
+		}
+	}
+}
+
+func TestZipfianSkew(t *testing.T) {
+	counts := make(map[interface{}]int)
+	for _, k := range Take(NewZipfian(1000, 1.2, 1), 10000) {
+		counts[k]++
+	}
+
+	if counts[0] <= counts[500] {
+		t.Fatalf("Zipfian generator should favor low ranks; Have %v hits for 0, %v hits for 500", counts[0], counts[500])
+	}
+}
+
+func TestScanIsSequential(t *testing.T) {
+	keys := Take(NewScan(3), 5)
+
+	for i, want := range []int{0, 1, 2, 0, 1} {
+		if keys[i] != want {
+			t.Fatalf("Scan order mismatch; Have %v", keys)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package sim
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+// Policy designates a cache configuration to be simulated, constructing an instance thereof per capacity
+type Policy struct {
+	Name string
+	New  func(capacity int) (tenure.LRUController, error)
+}
+
+// LRU returns the policy of an LRUCache configured by the given options
+func LRU(opts ...tenure.Option) Policy {
+	return Policy{
+		Name: "lru",
+		New: func(capacity int) (tenure.LRUController, error) {
+			return tenure.New(capacity, nil, opts...)
+		},
+	}
+}
+
+// Result reports the outcome of replaying a trace against a policy at a capacity
+type Result struct {
+	Policy   string
+	Capacity int
+	Hits     uint64
+	Misses   uint64
+}
+
+// HitRate returns the share of accesses that hit, or zero absent accesses
+func (r Result) HitRate() float64 {
+	if r.Hits+r.Misses == 0 {
+		return 0
+	}
+
+	return float64(r.Hits) / float64(r.Hits+r.Misses)
+}
+
+// Simulate replays a trace of key accesses against every policy at every capacity, as would a read-through cache:
+// each access is a Get, and each miss is followed by a Put of the key. Results are ordered by policy, then by
+// capacity, as given. Instances are closed, where they bear a Close method, once the trace is replayed
+func Simulate(trace []interface{}, capacities []int, policies ...Policy) ([]Result, error) {
+	results := make([]Result, 0, len(capacities)*len(policies))
+
+	for _, p := range policies {
+		for _, capacity := range capacities {
+			c, err := p.New(capacity)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize policy %s at capacity %d; see %v", p.Name, capacity, err)
+			}
+
+			r := Result{Policy: p.Name, Capacity: capacity}
+			for _, k := range trace {
+				if _, ok := c.Get(k); ok {
+					r.Hits++
+				} else {
+					r.Misses++
+					c.Put(k, struct{}{})
+				}
+			}
+
+			if closer, ok := c.(interface{ Close() error }); ok {
+				closer.Close()
+			}
+
+			results = append(results, r)
+		}
+	}
+
+	return results, nil
+}
+
+// WriteTable writes the results to `w` as an aligned table of policy, capacity, hits, misses and hit rate
+func WriteTable(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "POLICY\tCAPACITY\tHITS\tMISSES\tHIT RATE")
+
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.2f%%\n", r.Policy, r.Capacity, r.Hits, r.Misses, 100*r.HitRate())
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,79 @@
+package sim
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	tenure "github.com/MatthewZito/tenure-go"
+)
+
+func TestSimulate(t *testing.T) {
+	trace := Take(NewZipfian(1000, 1.2, 1), 20000)
+
+	results, err := Simulate(trace, []int{10, 100, 1000}, LRU(), LRU(tenure.WithLowWatermark(0.5)))
+	if err != nil {
+		t.Fatalf("Failed to simulate; see %v", err)
+	}
+
+	if len(results) != 6 {
+		t.Fatalf("Result count mismatch; Have %v, Want %v", len(results), 6)
+	}
+
+	for i, r := range results[:3] {
+		if r.Hits+r.Misses != uint64(len(trace)) {
+			t.Fatalf("Every access should be counted; Have %v, Want %v", r.Hits+r.Misses, len(trace))
+		}
+
+		if i > 0 && r.HitRate() <= results[i-1].HitRate() {
+			t.Fatalf("Hit rate should grow with capacity; Have %v at %v, %v at %v", r.HitRate(), r.Capacity, results[i-1].HitRate(), results[i-1].Capacity)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, results); err != nil {
+		t.Fatalf("Failed to write results; see %v", err)
+	}
+
+	if lines := strings.Count(buf.String(), "\n"); lines != 7 {
+		t.Fatalf("Table line count mismatch; Have %v, Want %v", lines, 7)
+	}
+}
+
+func TestScanDefeatsLRU(t *testing.T) {
+	results, err := Simulate(Take(NewScan(100), 1000), []int{99}, LRU())
+	if err != nil {
+		t.Fatalf("Failed to simulate; see %v", err)
+	}
+
+	if results[0].Hits != 0 {
+		t.Fatalf("A scan exceeding capacity should never hit under LRU; Have %v hits", results[0].Hits)
+	}
+}
+
+func TestSimulateRecordedTrace(t *testing.T) {
+	var buf bytes.Buffer
+	lru, err := tenure.New(4, nil, tenure.WithTraceRecording(tenure.TraceConfig{W: &buf}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		lru.Get(i % 3)
+	}
+	lru.Close()
+
+	trace, err := tenure.ReadTrace(&buf)
+	if err != nil {
+		t.Fatalf("Failed to read the trace; see %v", err)
+	}
+
+	results, err := Simulate(trace, []int{4}, LRU())
+	if err != nil {
+		t.Fatalf("Failed to simulate the trace; see %v", err)
+	}
+
+	if results[0].Misses != 3 || results[0].Hits != 97 {
+		t.Errorf("Simulated result mismatch; Have %v hits and %v misses, Want %v and %v", results[0].Hits, results[0].Misses, 97, 3)
+	}
+}
@@ -0,0 +1,30 @@
+package tenure
+
+// Sizer declares the cost of a key / value pair, e.g. the length of a byte slice, or the size of an encoded message
+type Sizer func(key, value interface{}) int64
+
+// WithSizer configures the cache to cost each entry by `s` upon insertion, such that costs need not be declared
+// on every Put via the Cost entry option, which nonetheless takes precedence
+// Costs so computed govern WithMaxEntryCost and WithMaxCost, and serve as the declared weights of a memory budget,
+// whose Weigher may then be omitted
+func WithSizer(s Sizer) Option {
+	return func(lc *LRUCache) {
+		lc.sizer = s
+	}
+}
+
+// WithMaxCost bounds the cache by the total cost of its entries, in addition to its capacity, evicting least
+// recently-used entries while the total exceeds `max`; see WithSizer and Cost
+// Unlike a memory budget, the bound applies to costs as declared, with neither sampling nor correction
+func WithMaxCost(max int64) Option {
+	return func(lc *LRUCache) {
+		lc.maxTotalCost = max
+	}
+}
+
+/* Utilities */
+
+// overCost reports whether the total cost of the cache's entries exceeds its maximum; the write lock must be held
+func (lc *LRUCache) overCost() bool {
+	return lc.maxTotalCost > 0 && lc.costs > lc.maxTotalCost
+}
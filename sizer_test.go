@@ -0,0 +1,56 @@
+package tenure
+
+import "testing"
+
+func TestSizer(t *testing.T) {
+	var evicted []interface{}
+
+	lru, err := New(100, func(key, value interface{}) {
+		evicted = append(evicted, key)
+	}, WithSizer(func(key, value interface{}) int64 {
+		return int64(len(value.([]byte)))
+	}), WithMaxCost(10), WithMaxEntryCost(8))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", make([]byte, 4))
+	lru.Put("b", make([]byte, 4))
+
+	if s := lru.Stats(); s.Cost != 8 {
+		t.Fatalf("Cost mismatch; Have %v, Want %v", s.Cost, 8)
+	}
+
+	if !lru.Put("c", make([]byte, 4)) || len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("Entries should be evicted while the total cost exceeds its maximum; Have %v", evicted)
+	}
+
+	if _, err := lru.TryPut("d", make([]byte, 9)); err != ErrTooLarge {
+		t.Fatalf("Error mismatch; Have %v, Want %v", err, ErrTooLarge)
+	}
+
+	lru.PutWith("e", make([]byte, 4), Cost(1))
+	lru.Del("b")
+
+	if s := lru.Stats(); s.Cost != 5 {
+		t.Fatalf("Cost mismatch; Have %v, Want %v", s.Cost, 5)
+	}
+}
+
+func TestSizerWeighsMemoryBudget(t *testing.T) {
+	lru, err := New(100, nil, WithSizer(func(key, value interface{}) int64 {
+		return 10
+	}), WithMemoryBudget(MemoryBudgetConfig{Budget: 25}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+	defer lru.Close()
+
+	for i := 0; i < 5; i++ {
+		lru.Put(i, i)
+	}
+
+	if declared, _, _ := lru.MemoryUsage(); declared != 20 || lru.Size() != 2 {
+		t.Fatalf("Declared weight mismatch; Have %v, Want %v", declared, 20)
+	}
+}
@@ -0,0 +1,374 @@
+package tenure
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/maphash"
+	"sync"
+)
+
+var _ LRUController = (*SlabCache)(nil)
+
+const (
+	// slabHeader is the size of the header preceding each record in a SlabCache's slab, i.e. the key's hash
+	// and the lengths of the key and value
+	slabHeader = 16
+	// slabCompactionFloor is the smallest slab size, in bytes, at which a SlabCache will compact its slab
+	slabCompactionFloor = 1 << 12
+)
+
+// SlabCache is an LRU cache that stores its entries serialized in a single byte slab, for caches holding
+// millions of entries, where the garbage collector's scanning of entry pointers becomes the bottleneck
+// Keys are strings and values byte slices; the slab holds no pointers and the index maps hashes to offsets,
+// so that neither is scanned by the garbage collector, whatever the number of entries
+// Records are appended to the slab in order of use, such that a hit appends a copy of its record and abandons
+// the original; the slab is compacted once abandoned records come to occupy half of it
+// Values are copied in upon insertion and out upon retrieval, and so may be reused by the caller. Keys whose
+// hashes collide displace one another, the displaced entry being passed to the eviction callback
+// SlabCache implements LRUController, whose methods accept only string keys and []byte values: Get, Peek
+// and Has report other keys as not extant, and Put and Del ignore them
+// All transactions utilize locks and are therefore thread-safe
+type SlabCache struct {
+	capacity      int
+	slab          []byte
+	head          int
+	used          int
+	index         map[uint64]int
+	seed          maphash.Seed
+	onItemEvicted Callback
+	lock          sync.Mutex
+}
+
+// NewSlabCache initializes a new slab-backed LRU cache with a buffer capacity of `bufCap` entries
+// It accepts as a second parameter a callback to be invoked upon eviction, as does New; the callback receives
+// the key as a string and the value as a []byte
+func NewSlabCache(bufCap int, onItemEvicted Callback) (*SlabCache, error) {
+	if bufCap <= 0 {
+		return nil, errors.New("an LRU Cache must be initialized with a whole number greater than zero")
+	}
+
+	return &SlabCache{
+		capacity:      bufCap,
+		index:         make(map[uint64]int, bufCap),
+		seed:          maphash.MakeSeed(),
+		onItemEvicted: onItemEvicted,
+	}, nil
+}
+
+// GetBytes attempts to retrieve a copy of the value for the given key, designating it as most recently-used
+func (sc *SlabCache) GetBytes(key string) (value []byte, ok bool) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	h := sc.hash(key)
+	off, ok := sc.find(h, key)
+	if !ok {
+		return nil, false
+	}
+
+	value = append([]byte(nil), sc.value(off)...)
+
+	// The record is appended anew, unless it is already the most recent
+	if off+sc.size(off) != len(sc.slab) {
+		sc.abandon(off)
+		sc.index[h] = sc.append(h, key, value)
+		sc.maybeCompact()
+	}
+
+	return value, true
+}
+
+// PutBytes adds or inserts a given key / value pair into the cache, designating it as most recently-used
+// Returns a boolean flag indicating whether an eviction occurred
+func (sc *SlabCache) PutBytes(key string, value []byte) (wasEvicted bool) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	h := sc.hash(key)
+	off, update := sc.index[h]
+	if update {
+		sc.abandon(off)
+		delete(sc.index, h)
+
+		if !sc.matches(off, key) {
+			// A colliding key is displaced
+			sc.evicted(off)
+			update, wasEvicted = false, true
+		}
+	}
+
+	sc.index[h] = sc.append(h, key, value)
+
+	if !update && len(sc.index) > sc.capacity {
+		sc.evictLRU()
+		wasEvicted = true
+	}
+
+	sc.maybeCompact()
+	return
+}
+
+// DelBytes deletes the entry for the given key, if extant
+// A boolean flag is returned, indicating whether of not the transaction occurred
+func (sc *SlabCache) DelBytes(key string) (wasDeleted bool) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	h := sc.hash(key)
+	off, ok := sc.find(h, key)
+	if !ok {
+		return false
+	}
+
+	sc.abandon(off)
+	delete(sc.index, h)
+	sc.maybeCompact()
+	return true
+}
+
+// Get attempts to retrieve the value for the given string key; see GetBytes
+func (sc *SlabCache) Get(key interface{}) (value interface{}, ok bool) {
+	if k, isString := key.(string); isString {
+		if v, ok := sc.GetBytes(k); ok {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// Put adds or inserts a given string key and []byte value into the cache; see PutBytes
+func (sc *SlabCache) Put(key, value interface{}) (wasEvicted bool) {
+	k, isString := key.(string)
+	v, isBytes := value.([]byte)
+	if !isString || !isBytes {
+		return false
+	}
+
+	return sc.PutBytes(k, v)
+}
+
+// Del deletes an item corresponding to a given string key from the cache; see DelBytes
+func (sc *SlabCache) Del(key interface{}) (wasDeleted bool) {
+	if k, isString := key.(string); isString {
+		return sc.DelBytes(k)
+	}
+
+	return false
+}
+
+// Keys returns a slice of the keys currently extant in the cache, from least to most recently-used
+func (sc *SlabCache) Keys() []interface{} {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	keys := make([]interface{}, 0, len(sc.index))
+	for off := sc.head; off < len(sc.slab); off += sc.size(off) {
+		if sc.live(off) {
+			keys = append(keys, sc.key(off))
+		}
+	}
+
+	return keys
+}
+
+// Peek returns a copy of the value for the given key without enacting the eviction policy, or nil if not extant
+func (sc *SlabCache) Peek(key interface{}) (value interface{}) {
+	k, isString := key.(string)
+	if !isString {
+		return nil
+	}
+
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	if off, ok := sc.find(sc.hash(k), k); ok {
+		return append([]byte(nil), sc.value(off)...)
+	}
+
+	return nil
+}
+
+// Has returns a boolean flag verifying the existence (or lack thereof)
+// of a given key in the cache without enacting the eviction policy
+func (sc *SlabCache) Has(key interface{}) (ok bool) {
+	k, isString := key.(string)
+	if !isString {
+		return false
+	}
+
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	_, ok = sc.find(sc.hash(k), k)
+	return
+}
+
+// Drop drops all items from the cache, invoking the eviction callback for each
+func (sc *SlabCache) Drop() {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	for len(sc.index) > 0 {
+		sc.evictLRU()
+	}
+
+	sc.slab, sc.head, sc.used = nil, 0, 0
+	sc.index = make(map[uint64]int, sc.capacity)
+}
+
+// Purge drops all items, as does Drop
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (sc *SlabCache) Purge() {
+	sc.Drop()
+}
+
+// Size returns the current size of the cache
+func (sc *SlabCache) Size() int {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	return len(sc.index)
+}
+
+// Capacity returns the current maximum buffer capacity of the cache
+func (sc *SlabCache) Capacity() int {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	return sc.capacity
+}
+
+// AdjustCapacity resizes the cache capacity, evicting least recently-used items where necessary
+func (sc *SlabCache) AdjustCapacity(bufCap int) (numEvicted int) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	for len(sc.index) > bufCap {
+		sc.evictLRU()
+		numEvicted++
+	}
+
+	sc.capacity = bufCap
+	sc.maybeCompact()
+	return
+}
+
+/* Utilities */
+
+func (sc *SlabCache) hash(key string) uint64 {
+	var h maphash.Hash
+	h.SetSeed(sc.seed)
+	h.WriteString(key)
+
+	return h.Sum64()
+}
+
+// find returns the offset of the live record for `key`, bearing hash `h`
+func (sc *SlabCache) find(h uint64, key string) (off int, ok bool) {
+	off, ok = sc.index[h]
+	if !ok || !sc.matches(off, key) {
+		return 0, false
+	}
+
+	return off, true
+}
+
+// append appends a record to the slab, returning its offset
+func (sc *SlabCache) append(h uint64, key string, value []byte) int {
+	off := len(sc.slab)
+
+	var hdr [slabHeader]byte
+	binary.LittleEndian.PutUint64(hdr[0:], h)
+	binary.LittleEndian.PutUint32(hdr[8:], uint32(len(key)))
+	binary.LittleEndian.PutUint32(hdr[12:], uint32(len(value)))
+
+	sc.slab = append(sc.slab, hdr[:]...)
+	sc.slab = append(sc.slab, key...)
+	sc.slab = append(sc.slab, value...)
+	sc.used += len(sc.slab) - off
+
+	return off
+}
+
+func (sc *SlabCache) lengths(off int) (keyLen, valueLen int) {
+	return int(binary.LittleEndian.Uint32(sc.slab[off+8:])), int(binary.LittleEndian.Uint32(sc.slab[off+12:]))
+}
+
+// size returns the size of the record at `off`, header inclusive
+func (sc *SlabCache) size(off int) int {
+	k, v := sc.lengths(off)
+	return slabHeader + k + v
+}
+
+func (sc *SlabCache) key(off int) string {
+	k, _ := sc.lengths(off)
+	return string(sc.slab[off+slabHeader : off+slabHeader+k])
+}
+
+// matches reports whether the record at `off` bears `key`, without copying its key out of the slab
+func (sc *SlabCache) matches(off int, key string) bool {
+	k, _ := sc.lengths(off)
+	return string(sc.slab[off+slabHeader:off+slabHeader+k]) == key
+}
+
+// value returns the value of the record at `off`, aliasing the slab
+func (sc *SlabCache) value(off int) []byte {
+	k, v := sc.lengths(off)
+	start := off + slabHeader + k
+	return sc.slab[start : start+v]
+}
+
+// live reports whether the record at `off` is the one indexed for its hash, rather than abandoned
+func (sc *SlabCache) live(off int) bool {
+	cur, ok := sc.index[binary.LittleEndian.Uint64(sc.slab[off:])]
+	return ok && cur == off
+}
+
+// evictLRU evicts the least recently-used entry, i.e. the first live record from the head of the slab
+func (sc *SlabCache) evictLRU() {
+	for sc.head < len(sc.slab) {
+		off := sc.head
+		sc.head += sc.size(off)
+
+		if sc.live(off) {
+			sc.abandon(off)
+			delete(sc.index, binary.LittleEndian.Uint64(sc.slab[off:]))
+			sc.evicted(off)
+			return
+		}
+	}
+}
+
+// evicted invokes the eviction callback for the record at `off`, which must no longer be indexed
+func (sc *SlabCache) evicted(off int) {
+	if sc.onItemEvicted != nil {
+		sc.onItemEvicted(sc.key(off), append([]byte(nil), sc.value(off)...))
+	}
+}
+
+// abandon discounts the record at `off` from the live records, as it is about to be superseded or removed
+func (sc *SlabCache) abandon(off int) {
+	sc.used -= sc.size(off)
+}
+
+// maybeCompact rewrites the slab with only its live records, once abandoned records occupy half of it
+// Live records are the last of their hash in the slab, such that records are still found live or abandoned
+// by their offsets while the index is being rewritten
+func (sc *SlabCache) maybeCompact() {
+	if len(sc.slab) < slabCompactionFloor || sc.used > len(sc.slab)/2 {
+		return
+	}
+
+	slab := make([]byte, 0, 2*sc.used)
+	for off := sc.head; off < len(sc.slab); off += sc.size(off) {
+		if sc.live(off) {
+			n := sc.size(off)
+			sc.index[binary.LittleEndian.Uint64(sc.slab[off:])] = len(slab)
+			slab = append(slab, sc.slab[off:off+n]...)
+		}
+	}
+
+	sc.slab, sc.head = slab, 0
+}
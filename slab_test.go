@@ -0,0 +1,92 @@
+package tenure
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSlabCache(t *testing.T) {
+	var evicted []interface{}
+
+	sc, err := NewSlabCache(3, func(key, value interface{}) {
+		evicted = append(evicted, key)
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	var c LRUController = sc
+
+	buf := []byte("one")
+	c.Put("a", buf)
+	copy(buf, "xxx")
+	c.Put("b", []byte("two"))
+	c.Put("c", []byte("three"))
+
+	if v, ok := sc.GetBytes("a"); !ok || string(v) != "one" {
+		t.Fatalf("GetBytes mismatch; Have %s, Want %v", v, "one")
+	}
+
+	if !c.Put("d", []byte("four")) {
+		t.Fatal("Put should report an eviction once capacity is exceeded")
+	}
+
+	if want := []interface{}{"b"}; !reflect.DeepEqual(evicted, want) {
+		t.Fatalf("Evicted keys mismatch; Have %v, Want %v", evicted, want)
+	}
+
+	if have, want := c.Keys(), []interface{}{"c", "a", "d"}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("Keys mismatch; Have %v, Want %v", have, want)
+	}
+
+	c.Put("c", []byte("updated"))
+	if v := c.Peek("c"); string(v.([]byte)) != "updated" || c.Size() != 3 {
+		t.Fatalf("Put should update the extant entry; Have %s, Want %v", v, "updated")
+	}
+
+	if c.Has(1) || c.Put(1, []byte{}) || c.Put("e", "value") || c.Del(1) {
+		t.Fatal("Keys other than strings and values other than byte slices should be ignored")
+	}
+
+	if !c.Del("a") || c.Has("a") || c.Size() != 2 {
+		t.Fatal("Del should remove the entry")
+	}
+
+	if n := c.AdjustCapacity(1); n != 1 || !c.Has("c") {
+		t.Fatalf("AdjustCapacity should evict least recently-used entries; Have %v, Want %v", n, 1)
+	}
+
+	c.Purge()
+	if c.Size() != 0 || len(c.Keys()) != 0 {
+		t.Fatal("Drop should remove all entries")
+	}
+}
+
+func TestSlabCacheCompaction(t *testing.T) {
+	sc, err := NewSlabCache(100, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	value := make([]byte, 100)
+	for i := 0; i < 10000; i++ {
+		k := fmt.Sprint(i % 200)
+		sc.PutBytes(k, value)
+		sc.GetBytes(fmt.Sprint(i % 50))
+	}
+
+	if sc.Size() != 100 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", sc.Size(), 100)
+	}
+
+	if max := 2 * 100 * (slabHeader + 3 + len(value)); len(sc.slab)-sc.head > max || sc.used > max/2 {
+		t.Fatalf("The slab should be compacted; Have %v bytes, Want at most %v", len(sc.slab)-sc.head, max)
+	}
+
+	for _, k := range sc.Keys() {
+		if v, ok := sc.GetBytes(k.(string)); !ok || len(v) != len(value) {
+			t.Fatalf("Entry %v should survive compaction intact", k)
+		}
+	}
+}
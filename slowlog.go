@@ -0,0 +1,178 @@
+package tenure
+
+import (
+	"sync"
+	"time"
+)
+
+// SlowOp designates the kind of operation recorded by a SlowEntry
+type SlowOp int
+
+const (
+	// SlowLoad records a call to the backing Store's Load
+	SlowLoad SlowOp = iota + 1
+	// SlowCallback records a dispatch of the eviction callback
+	SlowCallback
+	// SlowSnapshot records a rebuild of a SnapshotCache's snapshot
+	SlowSnapshot
+	// SlowLockWait records a wait to acquire the cache's lock for a keyed transaction
+	SlowLockWait
+)
+
+var slowOpNames = map[SlowOp]string{
+	SlowLoad:     "load",
+	SlowCallback: "callback",
+	SlowSnapshot: "snapshot",
+	SlowLockWait: "lock-wait",
+}
+
+// String returns the lowercase name of the operation
+func (op SlowOp) String() string {
+	return slowOpNames[op]
+}
+
+// MarshalText encodes the operation as its name
+func (op SlowOp) MarshalText() ([]byte, error) {
+	return []byte(op.String()), nil
+}
+
+// SlowLogConfig configures the slow log; see WithSlowLog
+// A zero threshold disables recording of the corresponding kind of operation
+type SlowLogConfig struct {
+	// Size is the number of entries retained, the oldest being discarded first
+	Size int
+	// Load is the threshold beyond which loads from the backing Store are recorded
+	Load time.Duration
+	// Callback is the threshold beyond which eviction callback dispatches are recorded
+	Callback time.Duration
+	// Snapshot is the threshold beyond which SnapshotCache rebuilds are recorded
+	Snapshot time.Duration
+	// LockWait is the threshold beyond which waits for the cache's lock are recorded
+	LockWait time.Duration
+}
+
+// SlowEntry is a single operation recorded in the slow log
+type SlowEntry struct {
+	// ID uniquely and monotonically identifies the entry
+	ID uint64
+	Op SlowOp
+	// Key is the redacted key upon which the operation was enacted, if any
+	Key      interface{}
+	Time     time.Time
+	Duration time.Duration
+}
+
+// WithSlowLog configures the cache to record operations exceeding the configured thresholds in a bounded,
+// in-memory log, after the fashion of Redis' SLOWLOG; see SlowLog
+func WithSlowLog(cfg SlowLogConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.Size <= 0 {
+			cfg.Size = 128
+		}
+
+		lc.slowlog = &slowLog{cfg: cfg, entries: make([]SlowEntry, 0, cfg.Size)}
+	}
+}
+
+// SlowLog returns the entries of the slow log, from newest to oldest
+func (lc *LRUCache) SlowLog() []SlowEntry {
+	s := lc.slowlog
+	if s == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	out := make([]SlowEntry, len(s.entries))
+	for i := range out {
+		out[i] = s.entries[(s.next-1-i+2*len(s.entries))%len(s.entries)]
+	}
+
+	return out
+}
+
+// ResetSlowLog discards every entry of the slow log
+func (lc *LRUCache) ResetSlowLog() {
+	s := lc.slowlog
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.entries, s.next = s.entries[:0], 0
+}
+
+/* Utilities */
+
+type slowLog struct {
+	cfg     SlowLogConfig
+	lock    sync.Mutex
+	entries []SlowEntry
+	next    int
+	id      uint64
+}
+
+func (s *slowLog) threshold(op SlowOp) time.Duration {
+	switch op {
+	case SlowLoad:
+		return s.cfg.Load
+	case SlowCallback:
+		return s.cfg.Callback
+	case SlowSnapshot:
+		return s.cfg.Snapshot
+	case SlowLockWait:
+		return s.cfg.LockWait
+	}
+
+	return 0
+}
+
+// timing reports whether operations of the given kind are being recorded
+func (lc *LRUCache) timing(op SlowOp) bool {
+	return lc.slowlog != nil && lc.slowlog.threshold(op) > 0
+}
+
+// observeSlow records an operation begun at `start` in the slow log, should it have exceeded its threshold
+func (lc *LRUCache) observeSlow(op SlowOp, key interface{}, start time.Time) {
+	s := lc.slowlog
+	if s == nil {
+		return
+	}
+
+	d := time.Since(start)
+	if t := s.threshold(op); t <= 0 || d < t {
+		return
+	}
+
+	if key != nil {
+		key, _ = lc.Redact(key, nil)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.id++
+	e := SlowEntry{ID: s.id, Op: op, Key: key, Time: lc.clock.Now(), Duration: d}
+
+	if len(s.entries) < s.cfg.Size {
+		s.entries = append(s.entries, e)
+	} else {
+		s.entries[s.next] = e
+	}
+	s.next = (s.next + 1) % s.cfg.Size
+}
+
+// acquire locks the cache for a keyed transaction, recording waits in the slow log
+func (lc *LRUCache) acquire(key interface{}) {
+	if !lc.timing(SlowLockWait) {
+		lc.lock.Lock()
+		return
+	}
+
+	start := time.Now()
+	lc.lock.Lock()
+	lc.observeSlow(SlowLockWait, key, start)
+}
@@ -0,0 +1,89 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+)
+
+type slowStore struct {
+	*mapStore
+	delay time.Duration
+}
+
+func (s slowStore) Load(key interface{}) (interface{}, error) {
+	time.Sleep(s.delay)
+	return s.mapStore.Load(key)
+}
+
+func TestSlowLogRecordsOperations(t *testing.T) {
+	store := slowStore{newMapStore(), 2 * time.Millisecond}
+	store.data["a"] = 1
+
+	lru, err := New(1, func(k, v interface{}) {
+		time.Sleep(2 * time.Millisecond)
+	}, WithStore(store), WithSlowLog(SlowLogConfig{Load: time.Millisecond, Callback: time.Millisecond, Snapshot: time.Hour}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Get("a")
+	lru.Put("b", 2)
+
+	sc := NewSnapshotCache(lru, 0)
+	sc.Rebuild()
+
+	log := lru.SlowLog()
+	if len(log) != 2 {
+		t.Fatalf("Slow log length mismatch; Have %v, Want %v", len(log), 2)
+	}
+
+	if log[0].Op != SlowCallback || log[0].Key != "a" || log[0].ID != 2 {
+		t.Fatalf("Slow log should be ordered newest first; Have %+v", log[0])
+	}
+
+	if log[1].Op != SlowLoad || log[1].Key != "a" || log[1].Duration < time.Millisecond {
+		t.Fatalf("Slow load mismatch; Have %+v", log[1])
+	}
+}
+
+func TestSlowLogIsBounded(t *testing.T) {
+	lru, err := New(1, func(k, v interface{}) {
+		time.Sleep(time.Millisecond)
+	}, WithSlowLog(SlowLogConfig{Size: 2, Callback: time.Nanosecond}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		lru.Put(i, i)
+	}
+
+	log := lru.SlowLog()
+	if len(log) != 2 || log[0].Key != 3 || log[1].Key != 2 {
+		t.Fatalf("Slow log should retain the newest entries; Have %+v", log)
+	}
+
+	lru.ResetSlowLog()
+	lru.Put(5, 5)
+
+	if log := lru.SlowLog(); len(log) != 1 || log[0].Key != 4 || log[0].ID != 5 {
+		t.Fatalf("Slow log reset mismatch; Have %+v", log)
+	}
+}
+
+func TestSlowLogRedactsKeys(t *testing.T) {
+	lru, err := New(4, nil, WithRedactor(func(k, v interface{}) (interface{}, interface{}) {
+		return "***", v
+	}), WithSlowLog(SlowLogConfig{LockWait: time.Nanosecond}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("secret", 1)
+
+	for _, e := range lru.SlowLog() {
+		if e.Op != SlowLockWait || e.Key != "***" {
+			t.Fatalf("Slow log keys should be redacted; Have %+v", e)
+		}
+	}
+}
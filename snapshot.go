@@ -0,0 +1,158 @@
+package tenure
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SnapshotCache fronts an LRUCache with a read-mostly serving tier
+// Reads are served lock-free from an immutable snapshot of the cache, which is periodically rebuilt
+// and swapped in atomically; writes accumulate in a small mutable overlay until the next rebuild
+// This mode is ideal for caches that are updated in batches but read millions of times per second
+// Reads served from the snapshot do not enact the eviction policy, and an entry evicted from the
+// underlying cache, or expiring there, remains readable until the next rebuild, which omits expired entries
+type SnapshotCache struct {
+	lru      *LRUCache
+	snapshot atomic.Value
+	pending  int32
+	lock     sync.RWMutex
+	overlay  map[interface{}]overlayEntry
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+type overlayEntry struct {
+	value   interface{}
+	deleted bool
+}
+
+// NewSnapshotCache initializes a new read-mostly serving tier over `lru`
+// If `interval` is greater than zero, the snapshot is rebuilt on that interval until Close is invoked;
+// else, the snapshot is only rebuilt upon explicit invocation of Rebuild
+func NewSnapshotCache(lru *LRUCache, interval time.Duration) *SnapshotCache {
+	sc := &SnapshotCache{
+		lru:     lru,
+		overlay: make(map[interface{}]overlayEntry),
+	}
+	sc.Rebuild()
+
+	if interval > 0 {
+		sc.stop = make(chan struct{})
+		sc.done = make(chan struct{})
+		go sc.run(interval)
+	}
+
+	return sc
+}
+
+// Get retrieves the value for the given key from the overlay, if pending, or else from the current snapshot
+func (sc *SnapshotCache) Get(key interface{}) (value interface{}, ok bool) {
+	key = sc.lru.mapKey(key)
+
+	if atomic.LoadInt32(&sc.pending) > 0 {
+		sc.lock.RLock()
+		e, pending := sc.overlay[key]
+		sc.lock.RUnlock()
+
+		if pending {
+			if e.deleted {
+				return nil, false
+			}
+			return e.value, true
+		}
+	}
+
+	value, ok = sc.snapshot.Load().(map[interface{}]interface{})[key]
+	return
+}
+
+// Put adds or inserts a given key / value pair into the underlying cache and, once it is held there, the overlay
+// Should the underlying cache refuse the entry, or evict it forthwith, the key is instead masked in the overlay,
+// unless the cache yet holds a prior value for it, e.g. upon a failed write through
+// Returns a boolean flag indicating whether an eviction occurred in the underlying cache
+func (sc *SnapshotCache) Put(key, value interface{}) (wasEvicted bool) {
+	value, err := sc.lru.admit(value)
+	if err != nil {
+		return false
+	}
+
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	wasEvicted, err = sc.lru.TryPut(key, value)
+
+	mapped := sc.lru.mapKey(key)
+	sc.lru.lock.RLock()
+	kv, held := sc.lru.cache[mapped]
+	held = held && !kv.negative && !kv.expired(sc.lru.clock.Now())
+	sc.lru.lock.RUnlock()
+
+	if !held {
+		sc.overlay[mapped] = overlayEntry{deleted: true}
+	} else if err == nil {
+		sc.overlay[mapped] = overlayEntry{value: value}
+	}
+	atomic.StoreInt32(&sc.pending, int32(len(sc.overlay)))
+
+	return wasEvicted
+}
+
+// Del deletes an item corresponding to a given key from the underlying cache, and masks it in the overlay
+// A boolean flag is returned, indicating whether of not the transaction occurred in the underlying cache
+func (sc *SnapshotCache) Del(key interface{}) (wasDeleted bool) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	sc.overlay[sc.lru.mapKey(key)] = overlayEntry{deleted: true}
+	atomic.StoreInt32(&sc.pending, int32(len(sc.overlay)))
+
+	return sc.lru.Del(key)
+}
+
+// Rebuild swaps in a fresh snapshot of the underlying cache and clears the overlay
+func (sc *SnapshotCache) Rebuild() {
+	if sc.lru.timing(SlowSnapshot) {
+		defer sc.lru.observeSlow(SlowSnapshot, nil, time.Now())
+	}
+
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	sc.lru.lock.RLock()
+	now := sc.lru.clock.Now()
+	m := make(map[interface{}]interface{}, len(sc.lru.cache))
+	for k, kv := range sc.lru.cache {
+		if kv.negative || kv.expired(now) {
+			continue
+		}
+
+		if v, err := sc.lru.decode(kv.value); err == nil {
+			m[k] = v
+		}
+	}
+	sc.lru.lock.RUnlock()
+
+	sc.snapshot.Store(m)
+	sc.overlay = make(map[interface{}]overlayEntry)
+	atomic.StoreInt32(&sc.pending, 0)
+}
+
+// Close halts periodic rebuilds, if configured
+func (sc *SnapshotCache) Close() {
+	if sc.stop == nil {
+		return
+	}
+
+	close(sc.stop)
+	<-sc.done
+	sc.stop = nil
+}
+
+func (sc *SnapshotCache) run(interval time.Duration) {
+	defer close(sc.done)
+
+	for pause(sc.lru.clock, interval, sc.stop) {
+		sc.Rebuild()
+	}
+}
@@ -0,0 +1,99 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestSnapshotOverlay(t *testing.T) {
+	lru, err := New(8, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+
+	sc := NewSnapshotCache(lru, 0)
+	defer sc.Close()
+
+	if v, ok := sc.Get("a"); !ok || v != 1 {
+		t.Fatalf("Snapshot read failure; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	sc.Put("b", 2)
+	sc.Del("a")
+
+	if v, ok := sc.Get("b"); !ok || v != 2 {
+		t.Fatalf("Overlay read failure; Have (%v, %v), Want (2, true)", v, ok)
+	}
+
+	if _, ok := sc.Get("a"); ok {
+		t.Fatal("Deleted keys should be masked by the overlay")
+	}
+
+	sc.Rebuild()
+
+	if sc.pending != 0 || len(sc.overlay) != 0 {
+		t.Fatalf("Rebuild should clear the overlay; Have %v pending", sc.pending)
+	}
+
+	if v, ok := sc.Get("b"); !ok || v != 2 {
+		t.Fatalf("Rebuilt snapshot read failure; Have (%v, %v), Want (2, true)", v, ok)
+	}
+
+	if _, ok := sc.Get("a"); ok {
+		t.Fatal("Rebuilt snapshot should not contain deleted keys")
+	}
+}
+
+func TestSnapshotOmitsExpired(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	lru, err := New(8, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithTTL("a", 1, 0, time.Second)
+	lru.Put("b", 2)
+	clock.Advance(2 * time.Second)
+
+	sc := NewSnapshotCache(lru, 0)
+	defer sc.Close()
+
+	if _, ok := sc.Get("a"); ok {
+		t.Error("Entries past their hard expiry should be omitted from the snapshot")
+	}
+
+	if v, ok := sc.Get("b"); !ok || v != 2 {
+		t.Errorf("Snapshot read failure; Have (%v, %v), Want (2, true)", v, ok)
+	}
+}
+
+func TestSnapshotOverlayRefusals(t *testing.T) {
+	lru, err := New(8, nil, WithSizer(func(key, value interface{}) int64 { return int64(value.(int)) }), WithMaxEntryCost(10))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+
+	sc := NewSnapshotCache(lru, 0)
+	defer sc.Close()
+
+	sc.Put("a", 100)
+	if v, ok := sc.Get("a"); ok {
+		t.Errorf("A value the underlying cache refuses should not be served from the overlay; Have %v", v)
+	}
+
+	sc.Put("b", 100)
+	if v, ok := sc.Get("b"); ok {
+		t.Errorf("A value the underlying cache refuses should not be served from the overlay; Have %v", v)
+	}
+
+	sc.Put("b", 2)
+	if v, ok := sc.Get("b"); !ok || v != 2 {
+		t.Errorf("Overlay read failure; Have (%v, %v), Want (2, true)", v, ok)
+	}
+}
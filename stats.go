@@ -0,0 +1,142 @@
+package tenure
+
+import "container/heap"
+
+// compactionFloor is the smallest peak size at which the cache will compact itself
+const compactionFloor = 64
+
+// Stats reports usage statistics of the cache
+type Stats struct {
+	// Hits is the number of lookups that found an entry
+	Hits uint64
+	// Misses is the number of lookups that found no entry, or an expired one
+	Misses uint64
+	// NegativeHits is the number of lookups that found a negative entry; see PutNegative
+	NegativeHits uint64
+	// Evictions is the number of entries removed by the eviction policy
+	Evictions uint64
+	// GhostHits is the number of misses on recently evicted keys, i.e. misses that would have hit with a
+	// larger capacity; it is only tracked while recently evicted keys are being retained
+	GhostHits uint64
+	// VerifyFailures is the number of sampled hits whose value failed verification
+	VerifyFailures uint64
+	// EarlyExpirations is the number of reads treated as misses ahead of expiry; see WithEarlyExpiration
+	EarlyExpirations uint64
+	// CallbackOverruns is the number of eviction callbacks that overran their deadline; see WithCallbackDeadline
+	CallbackOverruns uint64
+	// Cost is the total cost of the cache's entries; see WithSizer
+	Cost int64
+	// Latency reports the distributions of operation latencies; it is nil unless the cache is configured
+	// WithLatencyHistograms
+	Latency *LatencyStats
+	// Capacity is the current maximum buffer capacity of the cache
+	Capacity int
+	// ListLen is the length of the recency list
+	ListLen int
+	// MapLen is the length of the lookup map; it diverges from ListLen only if the cache is corrupted
+	MapLen int
+	// Protected is the number of entries that have been hit at least once since insertion, i.e. the warm working set
+	Protected int
+	// Probationary is the number of entries yet to be hit since insertion
+	Probationary int
+	// Compactions is the number of times the cache's internal structures have been rebuilt
+	Compactions uint64
+	// Repairs is the number of compactions that found and repaired a divergence between list and map
+	Repairs uint64
+}
+
+// Stats returns a snapshot of the cache's usage statistics
+func (lc *LRUCache) Stats() Stats {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	s := lc.stats
+	lc.accesses.count(&s)
+	s.Cost = lc.costs
+	s.Latency = lc.latency.snapshot()
+	s.Capacity = lc.capacity
+	s.ListLen = lc.links.Len()
+	s.MapLen = len(lc.cache)
+	s.Protected = lc.protected
+	s.Probationary = s.ListLen - lc.protected
+
+	return s
+}
+
+// Compact rebuilds the cache's lookup map from its recency list
+// Go maps never release memory as entries are deleted, so after mass deletions the map retains its
+// peak footprint; compaction reclaims it. Any entries found in only one of the two structures are
+// discarded, repairing divergence between them. Compaction is also enacted automatically after
+// mass deletions, and is rarely worth invoking directly
+func (lc *LRUCache) Compact() {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	lc.compact()
+}
+
+/* Utilities */
+
+func (lc *LRUCache) maybeCompact() {
+	n := len(lc.cache)
+
+	if n != lc.links.Len() || lc.peak >= compactionFloor && lc.removals >= lc.peak/2 && n <= lc.peak/2 {
+		lc.compact()
+	}
+}
+
+// reschedule rebuilds the expiry heap from the lookup map, discarding any entries no longer extant
+func (lc *LRUCache) reschedule() {
+	lc.expiries = lc.expiries[:0]
+	for _, kv := range lc.cache {
+		kv.slot = 0
+
+		if !kv.hardExpiry.IsZero() {
+			lc.expiries = append(lc.expiries, kv)
+			kv.slot = len(lc.expiries)
+		}
+	}
+
+	heap.Init(&lc.expiries)
+}
+
+func (lc *LRUCache) compact() {
+	diverged := len(lc.cache) != lc.links.Len()
+
+	m := make(map[interface{}]*pair, lc.links.Len())
+	for kv := lc.links.Front(); kv != nil; {
+		next := kv.Next()
+
+		if _, dup := m[kv.key]; dup || lc.cache[kv.key] != kv {
+			// The entry is unreachable from the map, or shadowed by a fresher entry
+			lc.passHand(kv)
+			lc.unrank(kv)
+			lc.links.Remove(kv)
+			diverged = true
+
+			if kv.class != nil {
+				kv.class.count--
+			}
+			lc.reprioritize(kv, 0)
+			lc.tally(kv, -1)
+
+			if kv.hits > 0 {
+				lc.protected--
+			}
+		} else {
+			m[kv.key] = kv
+		}
+
+		kv = next
+	}
+
+	if diverged {
+		lc.stats.Repairs++
+	}
+
+	lc.cache = m
+	lc.reschedule()
+	lc.peak = len(m)
+	lc.removals = 0
+	lc.stats.Compactions++
+}
@@ -0,0 +1,109 @@
+package tenure
+
+import (
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	lru, err := New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put(1, 1)
+	lru.Put(2, 2)
+	lru.Put(3, 3)
+	lru.Get(3)
+	lru.Get(1)
+
+	s := lru.Stats()
+	if s.Hits != 1 || s.Misses != 1 || s.Evictions != 1 {
+		t.Fatalf("Stats mismatch; Have %+v", s)
+	}
+
+	if s.ListLen != 2 || s.MapLen != 2 || s.Capacity != 2 {
+		t.Fatalf("Stats mismatch; Have %+v", s)
+	}
+}
+
+func TestCompactionAfterMassDeletion(t *testing.T) {
+	maxcap := 1024
+
+	lru, err := New(maxcap, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < maxcap; i++ {
+		lru.Put(i, i)
+	}
+
+	for i := 0; i < maxcap*3/4; i++ {
+		lru.Del(i)
+	}
+
+	s := lru.Stats()
+	if s.Compactions == 0 {
+		t.Fatal("Mass deletions should trigger compaction")
+	}
+
+	if s.ListLen != maxcap/4 || s.MapLen != maxcap/4 {
+		t.Fatalf("Compaction lost entries; Have %+v", s)
+	}
+
+	for i := maxcap * 3 / 4; i < maxcap; i++ {
+		if v, ok := lru.Get(i); !ok || v != i {
+			t.Fatalf("Compaction lost entry %v", i)
+		}
+	}
+}
+
+func TestCompactionRepairsDivergence(t *testing.T) {
+	lru, err := New(8, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+
+	// Simulate corruption wherein an element is unreachable from the map
+	delete(lru.cache, "a")
+
+	if s := lru.Stats(); s.ListLen == s.MapLen {
+		t.Fatalf("Expected divergence; Have %+v", s)
+	}
+
+	lru.Compact()
+
+	if s := lru.Stats(); s.ListLen != 1 || s.MapLen != 1 || s.Repairs != 1 {
+		t.Fatalf("Compaction failed to repair divergence; Have %+v", s)
+	}
+}
+
+func TestWarmColdClassification(t *testing.T) {
+	lru, err := New(4, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 4; i++ {
+		lru.Put(i, i)
+	}
+
+	lru.Get(0)
+	lru.Get(0)
+	lru.Get(1)
+
+	if s := lru.Stats(); s.Protected != 2 || s.Probationary != 2 {
+		t.Fatalf("Classification mismatch; Have %v protected, %v probationary, Want 2, 2", s.Protected, s.Probationary)
+	}
+
+	lru.Del(0)
+	lru.Put(4, 4)
+	lru.Put(5, 5)
+
+	if s := lru.Stats(); s.Protected != 1 || s.Probationary != 3 {
+		t.Fatalf("Classification mismatch; Have %v protected, %v probationary, Want 1, 3", s.Protected, s.Probationary)
+	}
+}
@@ -0,0 +1,140 @@
+package tenure
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned when a value exists neither in the cache nor in its backing Store
+// Store implementations should return ErrNotFound from Load when no value exists for a key
+var ErrNotFound = errors.New("key not found")
+
+// Store is a backing store, e.g. a database, over which the cache operates as a caching layer
+type Store interface {
+	Load(key interface{}) (value interface{}, err error)
+	Write(key, value interface{}) error
+	Delete(key interface{}) error
+}
+
+// WithStore configures the cache to read through to `s` on misses, populating itself with loaded values
+func WithStore(s Store) Option {
+	return func(lc *LRUCache) {
+		lc.store = s
+	}
+}
+
+// GetOrLoad attempts to retrieve the value for the given key from the cache
+// On a miss, the value is loaded from the backing Store, if configured, and inserted into the cache
+// Returns ErrNotFound if the value exists in neither, or any other error surfaced by the Store
+// A negative entry for the key is reported as ErrNotFound without consulting the Store; see PutNegative
+// The cache lock is not held while loading; concurrent loads of a key are serialized, such that the key is
+// loaded once and its waiters served from the cache, while loads of distinct keys proceed in parallel
+func (lc *LRUCache) GetOrLoad(key interface{}) (value interface{}, err error) {
+	// early, if set, is the revision of an entry extant but expired early, and current its value; see WithEarlyExpiration
+	var (
+		early   *revision
+		current interface{}
+	)
+
+	if kv, rev, ok := lc.lookup(lc.mapKey(key)); ok {
+		if kv.negative {
+			return nil, ErrNotFound
+		}
+
+		v, derr := lc.decode(kv.value)
+		switch {
+		case derr != nil:
+			// A value that fails to decode is reloaded, as is one that fails verification
+		case lc.expiresEarly(&kv):
+			early, current = &rev, v
+		case lc.verified(key, v, rev):
+			if lc.revalidator != nil && lc.revalidator.due(&kv, lc.clock.Now()) {
+				lc.revalidator.refresh(lc, key, rev)
+			}
+
+			return v, nil
+		}
+	}
+
+	if lc.store == nil {
+		return nil, ErrNotFound
+	}
+
+	mapped := lc.mapKey(key)
+	token := lc.keys.token(mapped)
+	lc.loading.Lock(token)
+	defer lc.loading.Unlock(token)
+
+	// A concurrent load of the key may have completed while this one awaited the key's lock
+	if value, negative, ok := lc.loaded(mapped, early); ok {
+		if negative {
+			return nil, ErrNotFound
+		}
+		return value, nil
+	}
+
+	// A write-behind cache may hold writes not yet flushed to the store, which take precedence
+	if value, deleted, ok := lc.writer.pending(key); ok {
+		if deleted {
+			return nil, ErrNotFound
+		}
+		return value, nil
+	}
+
+	start := time.Now()
+	if value, err = lc.load(key); err != nil {
+		// An entry expired early remains servable should its recomputation fail
+		if early != nil && err != ErrNotFound {
+			return current, nil
+		}
+		return nil, err
+	}
+	delta := time.Since(start)
+
+	if value, err = lc.admit(value); err != nil {
+		return nil, err
+	}
+
+	spec := entrySpec{}
+	if lc.revalidator != nil {
+		spec = lc.revalidator.spec()
+	}
+	spec.delta = delta
+
+	lc.insert(key, value, spec)
+	return value, nil
+}
+
+/* Utilities */
+
+// loaded retrieves the entry for an already-mapped key without enacting the eviction policy or recording stats
+// An entry at revision `skip`, if set, is reported as not extant
+func (lc *LRUCache) loaded(key interface{}, skip *revision) (value interface{}, negative bool, ok bool) {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	kv, ok := lc.cache[key]
+	if !ok {
+		return nil, false, false
+	}
+
+	if kv.expired(lc.clock.Now()) || skip != nil && kv == skip.p && kv.gen == skip.gen {
+		return nil, false, false
+	}
+
+	value, err := lc.decode(kv.value)
+	return value, kv.negative, err == nil
+}
+
+// load reads the value for a raw key from the backing store, recording slow loads in the slow log
+func (lc *LRUCache) load(key interface{}) (interface{}, error) {
+	if lc.latency != nil {
+		defer lc.latency.observe(latencyLoad, time.Now())
+	}
+
+	if lc.timing(SlowLoad) {
+		defer lc.observeSlow(SlowLoad, key, time.Now())
+	}
+
+	return lc.store.Load(key)
+}
@@ -0,0 +1,89 @@
+package tenure
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+type mapStore struct {
+	mu     sync.Mutex
+	data   map[interface{}]interface{}
+	loads  int
+	writes int
+	fail   error
+}
+
+func newMapStore() *mapStore {
+	return &mapStore{data: make(map[interface{}]interface{})}
+}
+
+func (ms *mapStore) Load(key interface{}) (interface{}, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.loads++
+	if v, ok := ms.data[key]; ok {
+		return v, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (ms *mapStore) Write(key, value interface{}) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.fail != nil {
+		return ms.fail
+	}
+
+	ms.writes++
+	ms.data[key] = value
+	return nil
+}
+
+func (ms *mapStore) Delete(key interface{}) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.fail != nil {
+		return ms.fail
+	}
+
+	delete(ms.data, key)
+	return nil
+}
+
+func TestReadThrough(t *testing.T) {
+	ms := newMapStore()
+	ms.data["a"] = 1
+
+	lru, err := New(4, nil, WithStore(ms))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	if v, ok := lru.Get("a"); !ok || v != 1 {
+		t.Fatalf("Read-through failure; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	if v, ok := lru.Get("a"); !ok || v != 1 || ms.loads != 1 {
+		t.Fatalf("Loaded values should populate the cache; Have %v loads, Want %v loads", ms.loads, 1)
+	}
+
+	if _, err := lru.GetOrLoad("b"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound; Have %v", err)
+	}
+
+	if lru.Has("b") {
+		t.Fatal("Failed loads should not populate the cache")
+	}
+}
+
+func (ms *mapStore) get(key interface{}) (v interface{}, ok bool) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	v, ok = ms.data[key]
+	return
+}
@@ -0,0 +1,256 @@
+package tenure
+
+import (
+	"errors"
+	"sync"
+)
+
+var _ LRUController = (*StringCache)(nil)
+
+// StringCache is an LRU cache specialized for string keys
+// Entries are indexed by a map keyed by string, sparing the interface hashing and comparison an LRUCache
+// incurs upon every transaction, and the typed methods (GetString, PutString and DelString) spare callers
+// the boxing of keys into interfaces. StringCache implements LRUController, whose methods accept only
+// string keys: Get, Peek and Has report other keys as not extant, and Put and Del ignore them
+// StringCache offers none of the optional behavior of an LRUCache, in exchange for its speed
+// All transactions utilize locks and are therefore thread-safe
+type StringCache struct {
+	capacity      int
+	root          stringEntry
+	cache         map[string]*stringEntry
+	onItemEvicted Callback
+	lock          sync.Mutex
+}
+
+// stringEntry is an entry in a StringCache, linked into its intrusive recency list
+type stringEntry struct {
+	key        string
+	value      interface{}
+	next, prev *stringEntry
+}
+
+// NewStringCache initializes a new string-keyed LRU cache with a buffer capacity of `bufCap`
+// It accepts as a second parameter a callback to be invoked upon eviction, as does New
+func NewStringCache(bufCap int, onItemEvicted Callback) (*StringCache, error) {
+	if bufCap <= 0 {
+		return nil, errors.New("an LRU Cache must be initialized with a whole number greater than zero")
+	}
+
+	sc := &StringCache{
+		capacity:      bufCap,
+		cache:         make(map[string]*stringEntry, bufCap),
+		onItemEvicted: onItemEvicted,
+	}
+	sc.root.next, sc.root.prev = &sc.root, &sc.root
+
+	return sc, nil
+}
+
+// GetString attempts to retrieve the value for the given key, designating it as most recently-used
+func (sc *StringCache) GetString(key string) (value interface{}, ok bool) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	e, ok := sc.cache[key]
+	if !ok {
+		return nil, false
+	}
+
+	sc.moveToFront(e)
+	return e.value, true
+}
+
+// PutString adds or inserts a given key / value pair into the cache, designating it as most recently-used
+// Returns a boolean flag indicating whether an eviction occurred
+func (sc *StringCache) PutString(key string, value interface{}) (wasEvicted bool) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	if e, ok := sc.cache[key]; ok {
+		e.value = value
+		sc.moveToFront(e)
+		return false
+	}
+
+	e := &stringEntry{key: key, value: value}
+	sc.cache[key] = e
+	sc.link(e)
+
+	if len(sc.cache) > sc.capacity {
+		sc.evict(sc.root.prev)
+		return true
+	}
+
+	return false
+}
+
+// DelString deletes the entry for the given key, if extant
+// A boolean flag is returned, indicating whether of not the transaction occurred
+func (sc *StringCache) DelString(key string) (wasDeleted bool) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	e, ok := sc.cache[key]
+	if !ok {
+		return false
+	}
+
+	sc.unlink(e)
+	delete(sc.cache, key)
+	return true
+}
+
+// Get attempts to retrieve the value for the given string key; see GetString
+func (sc *StringCache) Get(key interface{}) (value interface{}, ok bool) {
+	if k, isString := key.(string); isString {
+		return sc.GetString(k)
+	}
+
+	return nil, false
+}
+
+// Put adds or inserts a given string key / value pair into the cache; see PutString
+func (sc *StringCache) Put(key, value interface{}) (wasEvicted bool) {
+	if k, isString := key.(string); isString {
+		return sc.PutString(k, value)
+	}
+
+	return false
+}
+
+// Del deletes an item corresponding to a given string key from the cache; see DelString
+func (sc *StringCache) Del(key interface{}) (wasDeleted bool) {
+	if k, isString := key.(string); isString {
+		return sc.DelString(k)
+	}
+
+	return false
+}
+
+// Keys returns a slice of the keys currently extant in the cache, from least to most recently-used
+func (sc *StringCache) Keys() []interface{} {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	keys := make([]interface{}, 0, len(sc.cache))
+	for e := sc.root.prev; e != &sc.root; e = e.prev {
+		keys = append(keys, e.key)
+	}
+
+	return keys
+}
+
+// Peek returns the value for the given key without enacting the eviction policy, or nil if not extant
+func (sc *StringCache) Peek(key interface{}) (value interface{}) {
+	k, isString := key.(string)
+	if !isString {
+		return nil
+	}
+
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	if e, ok := sc.cache[k]; ok {
+		return e.value
+	}
+
+	return nil
+}
+
+// Has returns a boolean flag verifying the existence (or lack thereof)
+// of a given key in the cache without enacting the eviction policy
+func (sc *StringCache) Has(key interface{}) (ok bool) {
+	k, isString := key.(string)
+	if !isString {
+		return false
+	}
+
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	_, ok = sc.cache[k]
+	return
+}
+
+// Drop drops all items from the cache, invoking the eviction callback for each
+func (sc *StringCache) Drop() {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	for sc.root.prev != &sc.root {
+		sc.evict(sc.root.prev)
+	}
+
+	sc.cache = make(map[string]*stringEntry, sc.capacity)
+}
+
+// Purge drops all items, as does Drop
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (sc *StringCache) Purge() {
+	sc.Drop()
+}
+
+// Size returns the current size of the cache
+func (sc *StringCache) Size() int {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	return len(sc.cache)
+}
+
+// Capacity returns the current maximum buffer capacity of the cache
+func (sc *StringCache) Capacity() int {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	return sc.capacity
+}
+
+// AdjustCapacity resizes the cache capacity, evicting least recently-used items where necessary
+func (sc *StringCache) AdjustCapacity(bufCap int) (numEvicted int) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	for len(sc.cache) > bufCap {
+		sc.evict(sc.root.prev)
+		numEvicted++
+	}
+
+	sc.capacity = bufCap
+	return
+}
+
+/* Utilities */
+
+func (sc *StringCache) evict(e *stringEntry) {
+	sc.unlink(e)
+	delete(sc.cache, e.key)
+
+	if sc.onItemEvicted != nil {
+		sc.onItemEvicted(e.key, e.value)
+	}
+}
+
+// link inserts `e` at the front of the recency list
+func (sc *StringCache) link(e *stringEntry) {
+	e.prev = &sc.root
+	e.next = sc.root.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+func (sc *StringCache) unlink(e *stringEntry) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next, e.prev = nil, nil
+}
+
+func (sc *StringCache) moveToFront(e *stringEntry) {
+	if sc.root.next == e {
+		return
+	}
+
+	sc.unlink(e)
+	sc.link(e)
+}
@@ -0,0 +1,61 @@
+package tenure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringCache(t *testing.T) {
+	var evicted []interface{}
+
+	sc, err := NewStringCache(3, func(key, value interface{}) {
+		evicted = append(evicted, key)
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	var c LRUController = sc
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+
+	if !c.Put("d", 4) {
+		t.Fatal("Put should report an eviction once capacity is exceeded")
+	}
+
+	if want := []interface{}{"b"}; !reflect.DeepEqual(evicted, want) {
+		t.Fatalf("Evicted keys mismatch; Have %v, Want %v", evicted, want)
+	}
+
+	if have, want := c.Keys(), []interface{}{"c", "a", "d"}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("Keys mismatch; Have %v, Want %v", have, want)
+	}
+
+	if v, ok := sc.GetString("a"); !ok || v != 1 {
+		t.Fatalf("GetString mismatch; Have %v, Want %v", v, 1)
+	}
+
+	if c.Has(1) || c.Put(1, 1) || c.Del(1) || c.Peek(1) != nil {
+		t.Fatal("Non-string keys should be treated as not extant")
+	}
+
+	if !c.Del("c") || c.Has("c") || c.Size() != 2 {
+		t.Fatal("Del should remove the entry")
+	}
+
+	if n := c.AdjustCapacity(1); n != 1 || c.Size() != 1 || !c.Has("a") {
+		t.Fatalf("AdjustCapacity should evict least recently-used entries; Have %v, Want %v", n, 1)
+	}
+
+	c.Purge()
+	if c.Size() != 0 || len(c.Keys()) != 0 {
+		t.Fatal("Drop should remove all entries")
+	}
+
+	if _, err := NewStringCache(0, nil); err == nil {
+		t.Fatal("Expected an error for a capacity of zero")
+	}
+}
@@ -0,0 +1,64 @@
+package tenure
+
+import "time"
+
+// Swap stores the value for the given key, as does Put, returning the value it displaced, if any, and whether
+// an entry was extant for the key; expired and negative entries are reported as absent. The exchange is atomic,
+// such that no other write to the key intervenes between reading the old value and storing the new
+// The value is admitted and written to the backing store, if any, as by TryPut; should it be refused, Swap
+// reports no extant entry and the cache is unchanged
+func (lc *LRUCache) Swap(key, value interface{}) (old interface{}, existed bool) {
+	if lc.latency != nil {
+		defer lc.latency.observe(latencyPut, time.Now())
+	}
+
+	value, err := lc.admit(value)
+	if err != nil {
+		return nil, false
+	}
+
+	var outcome insertOutcome
+	spec := entrySpec{outcome: &outcome}
+	spec.cost, spec.costed = lc.cost(key, value, spec), true
+	if lc.oversized(spec.cost) {
+		lc.remove(key)
+		return nil, false
+	}
+
+	if err = lc.persist(key, value); err != nil {
+		return nil, false
+	}
+
+	defer lc.publish(key)
+	if lc.insert(key, value, spec); !outcome.existed {
+		return nil, false
+	}
+
+	return lc.decoded(outcome.old), true
+}
+
+// Replace stores the value for the given key only if an entry, neither expired nor negative, is extant for it,
+// returning whether the value was stored. The check and the store are atomic, such that an entry deleted or
+// evicted in the interim is never resurrected
+// Only the cache is consulted: a key extant in the backing store alone is not replaced. The value is written to
+// the backing store once it has replaced the extant entry; a failed write is reported via WithWriteErrorHandler
+func (lc *LRUCache) Replace(key, value interface{}) (replaced bool) {
+	if lc.latency != nil {
+		defer lc.latency.observe(latencyPut, time.Now())
+	}
+
+	value, err := lc.admit(value)
+	if err != nil {
+		return false
+	}
+
+	var outcome insertOutcome
+	if lc.insert(key, value, entrySpec{onlyExtant: true, outcome: &outcome}); !outcome.written {
+		return false
+	}
+
+	lc.persist(key, value)
+	lc.publish(key)
+
+	return true
+}
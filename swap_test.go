@@ -0,0 +1,65 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestSwap(t *testing.T) {
+	lru, _ := New(3, nil)
+
+	if old, existed := lru.Swap("a", 1); existed || old != nil {
+		t.Errorf("Swap of an absent key should report no extant entry; Have %v, %v", old, existed)
+	}
+
+	if old, existed := lru.Swap("a", 2); !existed || old != 1 {
+		t.Errorf("Swap should return the displaced value; Have %v, %v", old, existed)
+	}
+
+	if v, _ := lru.Get("a"); v != 2 {
+		t.Errorf("Value mismatch; Have %v, Want %v", v, 2)
+	}
+}
+
+func TestSwapExpired(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	lru, _ := New(3, nil, WithClock(clock))
+
+	lru.PutWithTTL("a", 1, 0, time.Second)
+	clock.Advance(2 * time.Second)
+
+	if old, existed := lru.Swap("a", 2); existed || old != nil {
+		t.Errorf("Swap should report expired entries as absent; Have %v, %v", old, existed)
+	}
+
+	if v, _ := lru.Get("a"); v != 2 {
+		t.Errorf("Value mismatch; Have %v, Want %v", v, 2)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	lru, _ := New(3, nil, WithClock(clock))
+
+	if lru.Replace("a", 1) || lru.Has("a") {
+		t.Fatal("Replace should not store the value of an absent key")
+	}
+
+	lru.Put("a", 1)
+	if !lru.Replace("a", 2) {
+		t.Fatal("Replace should store the value of an extant key")
+	}
+
+	if v, _ := lru.Get("a"); v != 2 {
+		t.Errorf("Value mismatch; Have %v, Want %v", v, 2)
+	}
+
+	lru.PutWithTTL("b", 1, 0, time.Second)
+	clock.Advance(2 * time.Second)
+
+	if lru.Replace("b", 2) || lru.Has("b") {
+		t.Error("Replace should not store the value of an expired key")
+	}
+}
@@ -1,9 +1,11 @@
 package tenure
 
 import (
-	"container/list"
 	"errors"
+	"hash/maphash"
+	"math/rand"
 	"sync"
+	"time"
 )
 
 type Callback func(key interface{}, value interface{})
@@ -15,57 +17,217 @@ type LRUController interface {
 	Keys() []interface{}
 	Peek(key interface{}) (value interface{})
 	Has(key interface{}) (ok bool)
+	// Purge drops all items; the controllers of this package name it Drop, of which Purge is an alias
 	Purge()
 	Size() int
 	AdjustCapacity(bufCap int) (numEvicted int)
 }
 
+var _ LRUController = (*LRUCache)(nil)
+
 type LRUCache struct {
 	capacity      int
-	links         *list.List
-	cache         map[interface{}]*list.Element
+	links         *recency
+	cache         map[interface{}]*pair
 	onItemEvicted Callback
 	lock          sync.RWMutex
+	reservations  []*reservation
+	clock         Clock
+	keySecret     []byte
+	keys          keyIndex
+	store         Store
+	redactor      Redactor
+	writeMode     WriteMode
+	writer        *writeBehind
+	onWriteError  func(key interface{}, err error)
+	stats         Stats
+	ttls          *TTLDistribution
+	debug         *debugger
+	invalidator   Invalidator
+	origin        string
+	onPubError    func(err error)
+	closers       []func() error
+	sinks         []func(Event)
+	watchers      map[interface{}][]*watcher
+	ghosts        *ghostList
+	verifier      Verifier
+	verifyRate    float64
+	janitor       *janitor
+	watchdog      *watchdog
+	nilPolicy     NilPolicy
+	slowlog       *slowLog
+	budget        *memoryBudget
+	revalidator   *revalidator
+	loading       KeyedMutex
+	updating      KeyedMutex
+	expiration    ExpirationMode
+	expiries      expiryHeap
+	early         *EarlyExpirationConfig
+	lowWater      float64
+	maxCost       int64
+	maxTotalCost  int64
+	costs         int64
+	sizer         Sizer
+	hot           *hotKeys
+	keyStats      *keyStats
+	latency       *latencies
+	tuner         *tuner
+	tracer        *tracer
+	policy        EvictionPolicy
+	hand          *pair
+	rng           *rand.Rand
+	lruK          int
+	ticks         uint64
+	histories     historyHeap
+	bands         map[int]int
+	namespaces    map[interface{}]*namespaceState
+	privacy       *privacy
+	pool          *sync.Pool
+	codec         *codec
+	accesses      *accessBuffer
+	seq           uint64
+	serial        uint64
+	protected     int
+	peak          int
+	removals      int
 }
 
+// Option configures optional behavior of an LRUCache at construction time
+type Option func(*LRUCache)
+
 type pair struct {
-	key   interface{}
-	value interface{}
+	key        interface{}
+	value      interface{}
+	class      *reservation
+	created    time.Time
+	softExpiry time.Time
+	hardExpiry time.Time
+	refreshAt  time.Time
+	sliding    bool
+	hardTTL    time.Duration
+	checksum   uint64
+	hits       uint64
+	provenance []byte
+	weight     int64
+	priority   int
+	negative   bool
+	// visited marks the entry as hit since the hand last passed over it; see EvictSieve
+	visited bool
+	// history holds the logical times of the entry's last references, most recent first, and kth the k-th
+	// thereof, or zero; see WithLRUK
+	history []uint64
+	kth     uint64
+	// rank is the entry's position in the history heap, offset by one; zero if absent
+	rank int
+	// delta is the measured cost of loading the value; see WithEarlyExpiration
+	delta time.Duration
+	// gen is incremented upon each stamping of the entry; see revision
+	gen uint64
+	// serial orders entries by insertion, and is never reassigned; see Scan
+	serial uint64
+	// slot is the entry's position in the expiry heap, offset by one; zero if absent
+	slot int
+	// next, prev and list link the entry into the recency list
+	next, prev *pair
+	list       *recency
 }
 
 // New initializes a new LRU cache with a buffer capacity of `bufCap`
 // It accepts as a second parameter a callback to be invoked upon successful invocation
 // of the Least Recently-Used cache policy i.e. when a key/value pair is removed
 // All transactions utilize locks and are therefore thread-safe
-func New(bufCap int, onItemEvicted Callback) (*LRUCache, error) {
+// Any number of trailing options may be passed to configure optional behavior
+func New(bufCap int, onItemEvicted Callback, opts ...Option) (*LRUCache, error) {
 	if bufCap <= 0 {
 		return nil, errors.New("an LRU Cache must be initialized with a whole number greater than zero")
 	}
 
 	c := &LRUCache{
 		capacity:      bufCap,
-		links:         list.New(),
-		cache:         make(map[interface{}]*list.Element, bufCap),
+		links:         newRecency(),
+		cache:         make(map[interface{}]*pair, bufCap),
 		onItemEvicted: onItemEvicted,
+		clock:         systemClock{},
+	}
+	c.keys.seed = maphash.MakeSeed()
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.writeMode != WriteAround && c.store == nil {
+		return nil, errors.New("a write-through or write-behind cache must be backed by a Store")
+	}
+
+	if c.keys.hashFn != nil && (c.keySecret != nil || c.writeMode == WriteBehind) {
+		return nil, errors.New("custom key functions cannot be combined with key hashing or write-behind")
+	}
+
+	// A memory budget requires a means of weighing entries
+	if c.budget != nil && c.budget.cfg.Weigher == nil && c.sizer == nil {
+		c.budget = nil
+	}
+
+	if c.revalidator != nil && c.store == nil {
+		return nil, errors.New("a stale-while-revalidate or refresh-ahead cache must be backed by a Store")
+	}
+
+	if c.reservedSlots() >= bufCap {
+		return nil, errors.New("reserved capacity must be less than the buffer capacity")
 	}
+
+	if c.writeMode == WriteBehind {
+		c.writer.start(c)
+		c.closers = append(c.closers, c.writer.close)
+	}
+
+	if c.janitor != nil {
+		c.janitor.start(c)
+		c.closers = append(c.closers, c.janitor.close)
+	}
+
+	if c.budget != nil {
+		c.budget.start(c)
+		c.closers = append(c.closers, c.budget.close)
+	}
+
+	if c.revalidator != nil {
+		c.closers = append(c.closers, c.revalidator.close)
+	}
+
+	if c.tuner != nil {
+		c.tuner.start(c)
+		c.closers = append(c.closers, c.tuner.close)
+	}
+
+	if c.invalidator != nil {
+		if err := c.subscribe(); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
 // Get attempts to retrieve the value for the given key from the cache
 // Returns the corresponding value and true if extant; else, returns nil, false
 // Get transactions will move the item to the head of the cache, designating it as most recently-used
+// If the cache is backed by a Store, misses are transparently read through to the store; see GetOrLoad
 func (lc *LRUCache) Get(key interface{}) (value interface{}, ok bool) {
-	lc.lock.Lock()
-	defer lc.lock.Unlock()
+	if lc.latency != nil {
+		defer lc.latency.observe(latencyGet, time.Now())
+	}
 
-	if kv, ok := lc.cache[key]; ok {
-		lc.links.MoveToFront(kv)
+	if lc.store != nil {
+		value, err := lc.GetOrLoad(key)
+		return value, err == nil
+	}
 
-		if kv.Value.(*pair) == nil {
-			return nil, false
+	if kv, rev, ok := lc.lookup(lc.mapKey(key)); ok && !kv.negative {
+		if v, err := lc.decode(kv.value); err == nil && !lc.expiresEarly(&kv) && lc.verified(key, v, rev) {
+			return v, true
 		}
-
-		return kv.Value.(*pair).value, true
 	}
 
 	return nil, false
@@ -76,88 +238,101 @@ func (lc *LRUCache) Get(key interface{}) (value interface{}, ok bool) {
 // If the cache has reached the specified capacity, Put transactions will also enact the eviction policy
 // thereby removing the least recently-used item
 // Returns a boolean flag indicating whether an eviction occurred
+// Put clears any TTLs previously set on the key; see PutWithTTL
 func (lc *LRUCache) Put(key, value interface{}) (wasEvicted bool) {
-	lc.lock.Lock()
-	defer lc.lock.Unlock()
-
-	if kv, ok := lc.cache[key]; ok {
-		lc.links.MoveToFront(kv)
-
-		kv.Value.(*pair).value = value
-
-		return false
-	}
-
-	kv := &pair{key, value}
-
-	k := lc.links.PushFront(kv)
-	lc.cache[key] = k
-
-	if lc.links.Len() > lc.capacity {
-		if kv := lc.links.Back(); kv != nil {
-			lc.purgeLRUItem(kv)
-			lc.tryEvict(kv)
-
-			return true
-		}
-	}
-
-	return false
+	wasEvicted, _ = lc.TryPut(key, value)
+	return
 }
 
 // Del deletes an item corresponding to a given key from the cache, if extant
 // A boolean flag is returned, indicating whether of not the transaction occurred
+// Should the deletion fail to propagate to the backing Store, the entry is left as is; see WithWriteThrough
 func (lc *LRUCache) Del(key interface{}) (wasDeleted bool) {
-	lc.lock.Lock()
-	defer lc.lock.Unlock()
-
-	if kv, ok := lc.cache[key]; ok {
-		lc.purgeLRUItem(kv)
+	if lc.latency != nil {
+		defer lc.latency.observe(latencyDel, time.Now())
+	}
 
-		return true
+	if err := lc.unpersist(key); err != nil {
+		return false
 	}
 
-	return false
+	wasDeleted = lc.remove(key)
+	lc.publish(key)
+
+	return
 }
 
 // Keys returns a slice of the keys currently extant in the cache
 func (lc *LRUCache) Keys() []interface{} {
+	lc.debug.reentry(lc, "Keys", nil)
+
 	lc.lock.RLock()
 	defer lc.lock.RUnlock()
 
 	keys := make([]interface{}, lc.links.Len())
 
 	for i, k := 0, lc.links.Back(); k != nil; k = k.Prev() {
-		keys[i] = k.Value.(*pair).key
+		keys[i] = k.key
 		i++
 	}
 
 	return keys
 }
 
+// Peek returns the value for the given key without enacting the eviction policy, or nil if not extant
+func (lc *LRUCache) Peek(key interface{}) (value interface{}) {
+	key = lc.mapKey(key)
+
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	if kv, ok := lc.cache[key]; ok && !kv.expired(lc.clock.Now()) && !kv.negative {
+		return lc.decoded(kv.value)
+	}
+
+	return nil
+}
+
 // Has returns a boolean flag verifying the existence (or lack thereof)
 // of a given key in the cache without enacting the eviction policy
 func (lc *LRUCache) Has(key interface{}) (ok bool) {
+	key = lc.mapKey(key)
+
 	lc.lock.Lock()
 	defer lc.lock.Unlock()
 
-	_, ok = lc.cache[key]
-	return
+	kv, ok := lc.cache[key]
+	return ok && !kv.expired(lc.clock.Now()) && !kv.negative
 }
 
 // Drop drops all items from the cache
 func (lc *LRUCache) Drop() {
+	lc.debug.reentry(lc, "Drop", nil)
+
 	lc.lock.Lock()
 	defer lc.lock.Unlock()
 
 	for _, v := range lc.cache {
-		if lc.onItemEvicted != nil {
-			lc.purgeLRUItem(v)
-			lc.tryEvict(v)
-		}
+		lc.purgeLRUItem(v)
+		lc.emit(EventEvict, v)
+		lc.tryEvict(v)
+		lc.release(v)
 	}
 
 	lc.links.Init()
+	lc.hand = nil
+	lc.histories = nil
+	lc.bands = nil
+	lc.cache = make(map[interface{}]*pair, lc.capacity)
+	lc.expiries = nil
+	lc.peak, lc.removals = 0, 0
+}
+
+// Purge drops all items, as does Drop
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (lc *LRUCache) Purge() {
+	lc.Drop()
 }
 
 // Size returns the current size of the cache
@@ -180,8 +355,10 @@ func (lc *LRUCache) Capacity() int {
 // Invoking this transaction will evict all least recently-used items
 // to adjust the cache, where necessary
 func (lc *LRUCache) AdjustCapacity(bufCap int) (numEvicted int) {
-	lc.lock.RLock()
-	defer lc.lock.RUnlock()
+	lc.debug.reentry(lc, "AdjustCapacity", nil)
+
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
 
 	diff := lc.links.Len() - bufCap
 
@@ -190,39 +367,388 @@ func (lc *LRUCache) AdjustCapacity(bufCap int) (numEvicted int) {
 	}
 
 	for i := 0; i < diff; i++ {
-		if kv := lc.links.Back(); kv != nil {
-			lc.purgeLRUItem(kv)
-			lc.tryEvict(kv)
+		if kv := lc.victim(); kv != nil {
+			lc.evict(kv)
 		}
 	}
 
 	lc.capacity = bufCap
+	lc.maybeCompact()
 
 	return diff
 }
 
 // LeastRecentlyUsed returns the least recently-used key / value pair, or nil if not extant
 func (lc *LRUCache) LeastRecentlyUsed() (key interface{}, value interface{}) {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
 	kv := lc.links.Back()
 	if kv != nil {
-		n := kv.Value.(*pair)
-		key, value = n.key, n.value
+		n := kv
+		key, value = n.key, lc.decoded(n.value)
 		return
 	}
 	return
 }
 
+// LeastRecentlyUsedN returns up to `n` of the least recently-used entries and their metadata, as does Inspect,
+// least recently-used first, without enacting the eviction policy; absent priorities and reservations, these are
+// the entries an AdjustCapacity shrink by `n` would evict under LRU eviction
+func (lc *LRUCache) LeastRecentlyUsedN(n int) []EntryInfo {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	entries := make([]EntryInfo, 0, lc.span(n))
+	for kv := lc.links.Back(); kv != nil && len(entries) < n; kv = kv.Prev() {
+		entries = append(entries, lc.info(kv))
+	}
+
+	return entries
+}
+
+// MostRecentlyUsedN returns up to `n` of the most recently-used entries and their metadata, as does Inspect,
+// most recently-used first, without enacting the eviction policy
+func (lc *LRUCache) MostRecentlyUsedN(n int) []EntryInfo {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	entries := make([]EntryInfo, 0, lc.span(n))
+	for kv := lc.links.Front(); kv != nil && len(entries) < n; kv = kv.Next() {
+		entries = append(entries, lc.info(kv))
+	}
+
+	return entries
+}
+
+// Close flushes any buffered writes and halts the cache's background workers and subscriptions
+// The cache remains usable afterwards, though no longer maintained in the background
+// Returns the first error encountered, if any
+func (lc *LRUCache) Close() (err error) {
+	lc.lock.Lock()
+	closers := lc.closers
+	lc.closers = nil
+	lc.lock.Unlock()
+
+	for _, c := range closers {
+		if cerr := c(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+
+	return
+}
+
 /* Utilities */
 
-func (lc *LRUCache) purgeLRUItem(e *list.Element) {
-	lc.links.Remove(e)
-	kv := e.Value.(*pair)
+// span clamps `n` to the range [0, size of the cache]; the read lock must be held
+func (lc *LRUCache) span(n int) int {
+	if n < 0 {
+		return 0
+	}
+
+	if size := lc.links.Len(); n > size {
+		return size
+	}
+
+	return n
+}
+
+// remove deletes the entry for a raw key from the cache alone
+func (lc *LRUCache) remove(key interface{}) bool {
+	key = lc.mapKey(key)
+
+	lc.acquire(key)
+	defer lc.lock.Unlock()
+
+	if kv, ok := lc.cache[key]; ok {
+		lc.purgeLRUItem(kv)
+		lc.emit(EventDelete, kv)
+		lc.release(kv)
+		lc.maybeCompact()
+
+		return true
+	}
+
+	return false
+}
+
+// insert adds or updates the entry for a raw key per the given specification
+// A value that fails to encode supersedes any prior entry for its key, which is removed, as is an oversized one
+func (lc *LRUCache) insert(key, value interface{}, spec entrySpec) (wasEvicted bool) {
+	encoded, err := lc.encode(value)
+	if err != nil {
+		if spec.ifCurrent == nil {
+			lc.remove(key)
+		}
+		return false
+	}
+
+	return lc.insertEncoded(key, value, encoded, spec)
+}
+
+// insertEncoded adds or updates the entry for a raw key bearing the given value, already encoded as `encoded`
+func (lc *LRUCache) insertEncoded(key, value, encoded interface{}, spec entrySpec) (wasEvicted bool) {
+	class := lc.classify(key)
+	weight := lc.cost(key, value, spec)
+	value = encoded
+	key = lc.mapKey(key)
+
+	lc.acquire(key)
+	defer lc.lock.Unlock()
+
+	return lc.place(key, value, class, weight, spec)
+}
+
+// place adds or updates the entry for an already-mapped key, bearing an encoded value of the given class and
+// cost, per the given specification; the write lock must be held
+func (lc *LRUCache) place(key, value interface{}, class *reservation, weight int64, spec entrySpec) (wasEvicted bool) {
+	key = lc.keys.bind(key)
+	if lc.oversized(weight) {
+		if p, ok := lc.cache[key]; ok && spec.ifCurrent == nil {
+			lc.purgeLRUItem(p)
+			lc.emit(EventDelete, p)
+			lc.release(p)
+		}
+		return false
+	}
+
+	now := lc.clock.Now()
+	if spec.mode == ExpireDefault {
+		spec.mode = lc.expiration
+	}
+
+	if p, ok := lc.cache[key]; ok {
+		if r := spec.ifCurrent; r != nil && (p != r.p || p.gen != r.gen) {
+			if spec.outcome != nil {
+				spec.outcome.conflict = true
+			}
+			return false
+		}
+
+		if spec.merge == MergeSkipExisting || spec.merge == MergeNewerWins && !spec.restore.created.After(p.created) {
+			return false
+		}
+
+		extant := !p.expired(now) && !p.negative
+		if spec.onlyExtant && !extant {
+			return false
+		}
+
+		if o := spec.outcome; o != nil {
+			o.written, o.existed = true, extant
+			if extant {
+				o.old = p.value
+			}
+		}
+
+		lc.promote(p)
+
+		p.value = value
+		p.stamp(now, spec)
+		lc.schedule(p)
+		lc.observeTTL(spec.soft, spec.hard)
+		p.checksum = lc.debug.checksum(value)
+		lc.reweigh(p, weight)
+		lc.reprioritize(p, spec.priority)
+		lc.emit(EventUpdate, p)
+
+		if spec.noEvict {
+			return false
+		}
+
+		wasEvicted = lc.enforceQuota(p)
+		return lc.shed() || wasEvicted
+	}
+
+	if r := spec.ifCurrent; r != nil && r.p != nil {
+		if spec.outcome != nil {
+			spec.outcome.conflict = true
+		}
+		return false
+	}
+
+	if spec.onlyExtant {
+		return false
+	}
+
+	if spec.outcome != nil {
+		spec.outcome.written = true
+	}
+
+	if lc.ghosts != nil {
+		lc.ghosts.remove(lc.keys.token(key))
+	}
+
+	kv := lc.newPair()
+	kv.key, kv.value, kv.class = key, value, class
+	lc.serial++
+	kv.serial = lc.serial
+	kv.stamp(now, spec)
+	lc.schedule(kv)
+	lc.observeTTL(spec.soft, spec.hard)
+	kv.checksum = lc.debug.checksum(value)
+	lc.tally(kv, 1)
+	lc.reweigh(kv, weight)
+	lc.reprioritize(kv, spec.priority)
+	if kv.class != nil {
+		kv.class.count++
+	}
+
+	lc.cache[key] = lc.links.PushFront(kv)
+	if lc.policy == EvictLRUK {
+		lc.reference(kv)
+	}
+
+	if len(lc.cache) > lc.peak {
+		lc.peak = len(lc.cache)
+	}
+
+	lc.emit(EventPut, kv)
+
+	if spec.noEvict {
+		return false
+	}
+
+	return lc.enforce(kv)
+}
+
+// enforce enacts the eviction policy upon the insertion of `p`, evicting entries while the cache exceeds its
+// capacity, its namespace quota, or its memory and cost budgets; the write lock must be held
+func (lc *LRUCache) enforce(p *pair) (wasEvicted bool) {
+	wasEvicted = lc.enforceQuota(p)
+
+	if lc.links.Len() > lc.capacity {
+		for low := lc.lowWatermark(); lc.links.Len() > low; {
+			kv := lc.victim()
+			if kv == nil || kv == lc.links.Front() {
+				break
+			}
+
+			lc.evict(kv)
+			wasEvicted = true
+		}
+	}
+
+	return lc.shed() || wasEvicted
+}
+
+// lookup retrieves the entry for an already-mapped key, designating it as most recently-used
+// Entries past their hard expiry are removed and reported as misses
+// The entry is returned as a copy taken under the lock, alongside its revision, as the entry itself may be
+// mutated, or recycled, once the lock is released
+func (lc *LRUCache) lookup(key interface{}) (hit pair, rev revision, ok bool) {
+	if lc.hot != nil {
+		lc.hot.record(lc, key)
+	}
+
+	if lc.tracer != nil {
+		lc.tracer.record(lc, key)
+	}
+
+	if lc.keyStats != nil {
+		defer func() { lc.keyStats.record(lc, key, ok) }()
+	}
+
+	if lc.accesses != nil {
+		if hit, rev, ok := lc.peek(key); ok {
+			return hit, rev, true
+		}
+	}
+
+	lc.acquire(key)
+	defer lc.lock.Unlock()
+
+	kv, ok := lc.cache[key]
+	if !ok {
+		lc.stats.Misses++
+		if lc.ghosts != nil && lc.ghosts.has(lc.keys.token(key)) {
+			lc.stats.GhostHits++
+		}
+		return pair{}, revision{}, false
+	}
+
+	// Entries bearing no hard expiry neither expire nor slide, sparing the read of the clock
+	if !kv.hardExpiry.IsZero() {
+		now := lc.clock.Now()
+		if kv.expired(now) {
+			lc.purgeLRUItem(kv)
+			lc.observeLifetime(kv, true)
+			lc.emit(EventExpire, kv)
+			lc.stats.Misses++
+			lc.release(kv)
+			return pair{}, revision{}, false
+		}
+
+		if kv.slide(now) {
+			lc.schedule(kv)
+		}
+	}
+
+	lc.promote(kv)
+	if kv.negative {
+		lc.stats.NegativeHits++
+	} else {
+		lc.stats.Hits++
+	}
+	if kv.hits == 0 {
+		lc.protected++
+	}
+	kv.hits++
+	lc.debug.verify(lc, kv)
+	return *kv, revision{kv, kv.gen}, true
+}
+
+func (lc *LRUCache) purgeLRUItem(kv *pair) {
+	lc.passHand(kv)
+	lc.links.Remove(kv)
 	delete(lc.cache, kv.key)
+	lc.keys.unbind(kv.key)
+	lc.unschedule(kv)
+	lc.unrank(kv)
+	lc.removals++
+	lc.reweigh(kv, 0)
+	lc.reprioritize(kv, 0)
+	lc.tally(kv, -1)
+
+	if kv.hits > 0 {
+		lc.protected--
+	}
+
+	if kv.class != nil {
+		kv.class.count--
+	}
+}
+
+func (lc *LRUCache) evict(kv *pair) {
+	lc.purgeLRUItem(kv)
+	lc.observeLifetime(kv, false)
+	lc.emit(EventEvict, kv)
+	if lc.ghosts != nil {
+		lc.ghosts.add(lc.keys.token(kv.key))
+	}
+	lc.tryEvict(kv)
+	lc.stats.Evictions++
+	if st := lc.namespaceOfPair(kv); st != nil {
+		st.evictions++
+	}
+	lc.release(kv)
 }
 
-func (lc *LRUCache) tryEvict(e *list.Element) {
+func (lc *LRUCache) tryEvict(kv *pair) {
 	if lc.onItemEvicted != nil {
-		kv := e.Value.(*pair)
-		lc.onItemEvicted(kv.key, kv.value)
+		if lc.timing(SlowCallback) {
+			defer lc.observeSlow(SlowCallback, kv.key, time.Now())
+		}
+
+		if lc.watchdog != nil {
+			if lc.watchdog.dispatch(lc.clock, lc.onItemEvicted, kv.key, lc.decoded(kv.value)) {
+				lc.stats.CallbackOverruns++
+			}
+			return
+		}
+
+		defer lc.debug.dispatching()()
+		lc.onItemEvicted(kv.key, lc.decoded(kv.value))
 	}
 }
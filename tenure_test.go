@@ -1,6 +1,8 @@
 package tenure
 
 import (
+	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -102,6 +104,43 @@ func TestRemoval(t *testing.T) {
 	r(maxcap - 3)
 }
 
+func TestRecentlyUsedN(t *testing.T) {
+	lru, err := New(5, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 1; i <= 4; i++ {
+		lru.Put(i, i)
+	}
+	lru.Get(1)
+
+	keysOf := func(entries []EntryInfo) (keys []interface{}) {
+		for _, e := range entries {
+			keys = append(keys, e.Key)
+		}
+		return
+	}
+
+	if keys := keysOf(lru.LeastRecentlyUsedN(2)); !reflect.DeepEqual(keys, []interface{}{2, 3}) {
+		t.Errorf("Least recently-used entries mismatch; Have %v, Want %v", keys, []interface{}{2, 3})
+	}
+
+	if keys := keysOf(lru.MostRecentlyUsedN(2)); !reflect.DeepEqual(keys, []interface{}{1, 4}) {
+		t.Errorf("Most recently-used entries mismatch; Have %v, Want %v", keys, []interface{}{1, 4})
+	}
+
+	if n := len(lru.LeastRecentlyUsedN(10)); n != 4 {
+		t.Errorf("Entry count mismatch; Have %v, Want %v", n, 4)
+	}
+
+	// Neither should enact the eviction policy
+	lru.AdjustCapacity(2)
+	if !lru.Has(1) || !lru.Has(4) {
+		t.Errorf("Previewing entries should not alter their recency; Have %v", lru.Keys())
+	}
+}
+
 func TestLeastRecentlyUsed(t *testing.T) {
 	maxcap := 3
 	evictions := 0
@@ -152,6 +191,29 @@ func TestLeastRecentlyUsed(t *testing.T) {
 	}
 }
 
+func TestDropWithoutCallback(t *testing.T) {
+	lru, _ := New(4, nil, WithPrefixReservation("vip:", 2))
+
+	lru.Put("vip:a", 1)
+	lru.Put("vip:b", 2)
+	lru.Drop()
+
+	if lru.Size() != 0 {
+		t.Fatalf("Expected drop to remove all keys; Have %v keys, Want %v keys", lru.Size(), 0)
+	}
+
+	// Entries dropped must release their reserved slots, else those of their successors go unprotected
+	lru.Put("vip:a", 1)
+	lru.Put("vip:b", 2)
+	for i := 0; i < 3; i++ {
+		lru.Put(i, i)
+	}
+
+	if !lru.Has("vip:a") || !lru.Has("vip:b") {
+		t.Fatalf("Reserved entries should survive eviction after a drop; Have %v", lru.Keys())
+	}
+}
+
 func TestHasIsInconsequential(t *testing.T) {
 	maxcap := 9
 	evictions := 0
@@ -213,6 +275,30 @@ func TestCapAdjustment(t *testing.T) {
 	}
 }
 
+func TestCapAdjustmentConcurrent(t *testing.T) {
+	lru, _ := New(64, nil)
+	for i := 0; i < 64; i++ {
+		lru.Put(i, i)
+	}
+
+	// Adjustments mutate the cache, and so must exclude one another
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				lru.AdjustCapacity(16 + (g*7+i)%48)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if lru.Size() > lru.Capacity() {
+		t.Fatalf("Concurrent adjustments should leave the cache within capacity; Have %v, Want at most %v", lru.Size(), lru.Capacity())
+	}
+}
+
 func TestMitigations(t *testing.T) {
 	maxcap := 9
 	evictions := 0
@@ -0,0 +1,11 @@
+module github.com/MatthewZito/tenure-go/tenuregrpc
+
+go 1.19
+
+require (
+	github.com/MatthewZito/tenure-go v0.0.0
+	google.golang.org/grpc v1.56.3
+	google.golang.org/protobuf v1.31.0
+)
+
+replace github.com/MatthewZito/tenure-go => ../
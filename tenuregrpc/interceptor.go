@@ -0,0 +1,57 @@
+package tenuregrpc
+
+import (
+	"context"
+	"fmt"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/grpcapi"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewMemoizer initializes a new Memoizer over `c`, as does grpcapi.NewMemoizer, though requests and replies are
+// serialized as protobuf messages by default; marshaling is deterministic, such that equal requests are memoized
+// under the same key
+func NewMemoizer(c *tenure.LRUCache, cfg grpcapi.MemoizeConfig) *grpcapi.Memoizer {
+	if cfg.Marshal == nil {
+		cfg.Marshal = marshal
+	}
+
+	if cfg.Unmarshal == nil {
+		cfg.Unmarshal = unmarshal
+	}
+
+	return grpcapi.NewMemoizer(c, cfg)
+}
+
+// UnaryClientInterceptor returns a grpc.UnaryClientInterceptor serving the idempotent unary calls configured of
+// `m` from its memoized replies, and invoking all others as is, e.g. by way of grpc.WithUnaryInterceptor
+func UnaryClientInterceptor(m *grpcapi.Memoizer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return m.Invoke(ctx, method, req, reply, func(ctx context.Context, method string, req, reply interface{}) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+}
+
+/* Utilities */
+
+func marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", v)
+	}
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
+
+func unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%T is not a protobuf message", v)
+	}
+
+	return proto.Unmarshal(data, msg)
+}
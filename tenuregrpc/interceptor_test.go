@@ -0,0 +1,48 @@
+package tenuregrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/grpcapi"
+	"github.com/MatthewZito/tenure-go/tenuregrpc/tenurev1"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	const method = "/tenure.v1.Cache/Get"
+
+	lru, err := tenure.New(8, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	intercept := UnaryClientInterceptor(NewMemoizer(lru, grpcapi.MemoizeConfig{
+		Methods: map[string]time.Duration{method: time.Minute},
+	}))
+
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		reply.(*tenurev1.GetResponse).Value = []byte(req.(*tenurev1.GetRequest).GetKey())
+		reply.(*tenurev1.GetResponse).Found = true
+		return nil
+	}
+
+	for i := 0; i < 2; i++ {
+		reply := &tenurev1.GetResponse{}
+		if err := intercept(context.Background(), method, &tenurev1.GetRequest{Key: "a"}, reply, nil, invoker); err != nil {
+			t.Fatalf("Interceptor failure; see %v", err)
+		}
+
+		if !reply.GetFound() || string(reply.GetValue()) != "a" {
+			t.Fatalf("Reply mismatch; Have %v", reply)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Memoized calls should not be invoked anew; Have %v calls, Want %v", calls, 1)
+	}
+}
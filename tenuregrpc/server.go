@@ -0,0 +1,112 @@
+// Package tenuregrpc serves a tenure LRUCache over gRPC by way of the bindings generated from grpcapi/tenure.proto
+// into the tenurev1 package. It is a module apart from tenure, such that only its importers depend on gRPC and
+// protobuf; grpcapi itself remains free of either.
+//
+// The bindings are generated with go generate, which requires protoc, protoc-gen-go and protoc-gen-go-grpc
+package tenuregrpc
+
+//go:generate protoc -I ../grpcapi --go_out=. --go_opt=module=github.com/MatthewZito/tenure-go/tenuregrpc --go-grpc_out=. --go-grpc_opt=module=github.com/MatthewZito/tenure-go/tenuregrpc ../grpcapi/tenure.proto
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MatthewZito/tenure-go/grpcapi"
+	"github.com/MatthewZito/tenure-go/tenuregrpc/tenurev1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Register registers the Cache service with `gs`, served by `s`
+func Register(gs grpc.ServiceRegistrar, s *grpcapi.Server) {
+	tenurev1.RegisterCacheServer(gs, NewCacheServer(s))
+}
+
+// NewCacheServer returns the generated CacheServer served by `s`, converting each request to its grpcapi mirror
+// and each reply back
+func NewCacheServer(s *grpcapi.Server) tenurev1.CacheServer {
+	return cacheServer{s: s}
+}
+
+type cacheServer struct {
+	tenurev1.UnimplementedCacheServer
+	s *grpcapi.Server
+}
+
+func (cs cacheServer) Get(ctx context.Context, req *tenurev1.GetRequest) (*tenurev1.GetResponse, error) {
+	res, err := cs.s.Get(ctx, &grpcapi.GetRequest{Key: req.GetKey()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenurev1.GetResponse{Value: res.Value, Found: res.Found}, nil
+}
+
+func (cs cacheServer) Put(ctx context.Context, req *tenurev1.PutRequest) (*tenurev1.PutResponse, error) {
+	res, err := cs.s.Put(ctx, &grpcapi.PutRequest{Key: req.GetKey(), Value: req.GetValue()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenurev1.PutResponse{Evicted: res.Evicted}, nil
+}
+
+func (cs cacheServer) Del(ctx context.Context, req *tenurev1.DelRequest) (*tenurev1.DelResponse, error) {
+	res, err := cs.s.Del(ctx, &grpcapi.DelRequest{Key: req.GetKey()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenurev1.DelResponse{Deleted: res.Deleted}, nil
+}
+
+func (cs cacheServer) Keys(ctx context.Context, _ *tenurev1.KeysRequest) (*tenurev1.KeysResponse, error) {
+	res, err := cs.s.Keys(ctx, &grpcapi.KeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenurev1.KeysResponse{Keys: res.Keys}, nil
+}
+
+func (cs cacheServer) Stats(ctx context.Context, _ *tenurev1.StatsRequest) (*tenurev1.StatsResponse, error) {
+	res, err := cs.s.Stats(ctx, &grpcapi.StatsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenurev1.StatsResponse{
+		Hits:      res.Hits,
+		Misses:    res.Misses,
+		Evictions: res.Evictions,
+		Capacity:  res.Capacity,
+		Size:      res.Size,
+	}, nil
+}
+
+// Watch streams every mutation notified to the Server; a watcher disconnected for falling behind is reported
+// with codes.ResourceExhausted
+func (cs cacheServer) Watch(_ *tenurev1.WatchRequest, stream tenurev1.Cache_WatchServer) error {
+	err := cs.s.Watch(&grpcapi.WatchRequest{}, watchStream{stream})
+	if errors.Is(err, grpcapi.ErrWatchOverflow) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return err
+}
+
+// watchStream adapts a generated Watch stream to grpcapi.Cache_WatchServer
+type watchStream struct {
+	tenurev1.Cache_WatchServer
+}
+
+func (w watchStream) Send(e *grpcapi.Event) error {
+	return w.Cache_WatchServer.Send(&tenurev1.Event{
+		Seq:          e.Seq,
+		Op:           e.Op,
+		Key:          e.Key,
+		Value:        e.Value,
+		TimeUnixNano: e.TimeUnixNano,
+	})
+}
@@ -0,0 +1,43 @@
+package tenuregrpc
+
+import (
+	"context"
+	"testing"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/grpcapi"
+	"github.com/MatthewZito/tenure-go/tenuregrpc/tenurev1"
+)
+
+func TestCacheServer(t *testing.T) {
+	lru, err := tenure.New(2, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	cs := NewCacheServer(grpcapi.NewServer(lru))
+	ctx := context.Background()
+
+	if _, err := cs.Put(ctx, &tenurev1.PutRequest{Key: "a", Value: []byte("1")}); err != nil {
+		t.Fatalf("Put failure; see %v", err)
+	}
+
+	res, err := cs.Get(ctx, &tenurev1.GetRequest{Key: "a"})
+	if err != nil || !res.GetFound() || string(res.GetValue()) != "1" {
+		t.Fatalf("Get failure; Have (%v, %v), Want (1, true)", res, err)
+	}
+
+	keys, err := cs.Keys(ctx, &tenurev1.KeysRequest{})
+	if err != nil || len(keys.GetKeys()) != 1 || keys.GetKeys()[0] != "a" {
+		t.Fatalf("Keys failure; Have (%v, %v)", keys, err)
+	}
+
+	if res, err := cs.Del(ctx, &tenurev1.DelRequest{Key: "a"}); err != nil || !res.GetDeleted() {
+		t.Fatalf("Del failure; Have (%v, %v)", res, err)
+	}
+
+	stats, err := cs.Stats(ctx, &tenurev1.StatsRequest{})
+	if err != nil || stats.GetHits() != 1 || stats.GetSize() != 0 || stats.GetCapacity() != 2 {
+		t.Errorf("Stats mismatch; Have (%v, %v)", stats, err)
+	}
+}
@@ -0,0 +1,112 @@
+// Package testutil provides test doubles for code built atop tenure
+package testutil
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock is a manually advanced clock satisfying tenure's Clock interface
+// Its timers fire only as the clock is advanced past their deadlines, such that background work scheduled
+// by the cache proceeds in step with the test; see BlockUntil. It is safe for concurrent use
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+	armed  *sync.Cond
+}
+
+type fakeTimer struct {
+	deadline time.Time
+	c        chan time.Time
+}
+
+// NewFakeClock initializes a new FakeClock frozen at `start`
+func NewFakeClock(start time.Time) *FakeClock {
+	fc := &FakeClock{now: start}
+	fc.armed = sync.NewCond(&fc.mu)
+
+	return fc
+}
+
+// Now returns the clock's current time
+func (fc *FakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	return fc.now
+}
+
+// NewTimer returns a channel that receives the clock's time once it is advanced by `d`, and a function that
+// stops the timer, reporting whether it was stopped before firing
+func (fc *FakeClock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	t := &fakeTimer{deadline: fc.now.Add(d), c: make(chan time.Time, 1)}
+	fc.timers = append(fc.timers, t)
+	fc.fire()
+
+	return t.c, func() bool {
+		fc.mu.Lock()
+		defer fc.mu.Unlock()
+
+		for i, pending := range fc.timers {
+			if pending == t {
+				fc.timers = append(fc.timers[:i], fc.timers[i+1:]...)
+				fc.armed.Broadcast()
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Advance moves the clock forward by `d`, firing every timer whose deadline is thereby reached
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	fc.now = fc.now.Add(d)
+	fc.fire()
+}
+
+// Set moves the clock to `t`, firing every timer whose deadline is thereby reached
+func (fc *FakeClock) Set(t time.Time) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	fc.now = t
+	fc.fire()
+}
+
+// BlockUntil blocks until exactly `n` timers are pending, i.e. neither fired nor stopped
+// As a background worker arms its next timer once it has finished the work the last one scheduled, a test may
+// advance the clock, then block until the worker's timer is pending anew, to await the worker's progress
+func (fc *FakeClock) BlockUntil(n int) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	for len(fc.timers) != n {
+		fc.armed.Wait()
+	}
+}
+
+// fire delivers the current time to every pending timer whose deadline has been reached; the lock must be held
+func (fc *FakeClock) fire() {
+	pending := fc.timers[:0]
+	for _, t := range fc.timers {
+		if t.deadline.After(fc.now) {
+			pending = append(pending, t)
+		} else {
+			t.c <- fc.now
+		}
+	}
+
+	for i := len(pending); i < len(fc.timers); i++ {
+		fc.timers[i] = nil
+	}
+	fc.timers = pending
+	fc.armed.Broadcast()
+}
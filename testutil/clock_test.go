@@ -0,0 +1,56 @@
+package testutil_test
+
+import (
+	"testing"
+	"time"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+var _ tenure.Clock = (*testutil.FakeClock)(nil)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := testutil.NewFakeClock(start)
+
+	if !fc.Now().Equal(start) {
+		t.Fatalf("Clock drifted; Have %v, Want %v", fc.Now(), start)
+	}
+
+	fc.Advance(time.Minute)
+	if want := start.Add(time.Minute); !fc.Now().Equal(want) {
+		t.Fatalf("Advance failure; Have %v, Want %v", fc.Now(), want)
+	}
+
+	fc.Set(start)
+	if !fc.Now().Equal(start) {
+		t.Fatalf("Set failure; Have %v, Want %v", fc.Now(), start)
+	}
+}
+
+func TestFakeClockTimers(t *testing.T) {
+	fc := testutil.NewFakeClock(time.Unix(0, 0))
+
+	fired, _ := fc.NewTimer(time.Second)
+	_, stop := fc.NewTimer(time.Second)
+	fc.BlockUntil(2)
+
+	if !stop() || stop() {
+		t.Fatal("Stop should report whether the timer was pending")
+	}
+
+	fc.Advance(999 * time.Millisecond)
+	select {
+	case <-fired:
+		t.Fatal("Timers should not fire before their deadline")
+	default:
+	}
+
+	fc.Advance(time.Millisecond)
+	if now := <-fired; !now.Equal(time.Unix(1, 0)) {
+		t.Fatalf("Timers should receive the time at which they fired; Have %v, Want %v", now, time.Unix(1, 0))
+	}
+
+	fc.BlockUntil(0)
+}
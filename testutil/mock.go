@@ -0,0 +1,223 @@
+package testutil
+
+import "sync"
+
+// Call records a single invocation of a Mock method
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+type stub struct {
+	value interface{}
+	ok    bool
+}
+
+// Mock is an in-memory fake satisfying tenure's LRUController interface
+// Entries are stored without bound or eviction; Get results may be scripted per key via StubGet,
+// and every invocation is recorded for later assertion. It is safe for concurrent use
+type Mock struct {
+	mu       sync.Mutex
+	data     map[interface{}]interface{}
+	order    []interface{}
+	stubs    map[interface{}]stub
+	missAll  bool
+	capacity int
+	calls    []Call
+}
+
+// NewMock initializes a new, empty Mock
+func NewMock() *Mock {
+	return &Mock{
+		data:  make(map[interface{}]interface{}),
+		stubs: make(map[interface{}]stub),
+	}
+}
+
+// StubGet scripts the result of every subsequent Get for the given key, regardless of the Mock's contents
+func (m *Mock) StubGet(key, value interface{}, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stubs[key] = stub{value, ok}
+}
+
+// MissAll forces every subsequent unstubbed Get to miss when `miss` is true
+func (m *Mock) MissAll(miss bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.missAll = miss
+}
+
+// Calls returns every recorded invocation, in order
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallCount returns the number of recorded invocations of the given method
+func (m *Mock) CallCount(method string) (n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, c := range m.calls {
+		if c.Method == method {
+			n++
+		}
+	}
+
+	return
+}
+
+// Reset clears all recorded invocations
+func (m *Mock) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+}
+
+// Get returns the stubbed result for the given key if scripted; else, the stored value, if extant
+func (m *Mock) Get(key interface{}) (value interface{}, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Get", key)
+
+	if s, stubbed := m.stubs[key]; stubbed {
+		return s.value, s.ok
+	}
+
+	if m.missAll {
+		return nil, false
+	}
+
+	value, ok = m.data[key]
+	return
+}
+
+// Put stores the given key / value pair; the Mock never evicts
+func (m *Mock) Put(key, value interface{}) (wasEvicted bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Put", key, value)
+
+	if _, ok := m.data[key]; !ok {
+		m.order = append(m.order, key)
+	}
+	m.data[key] = value
+
+	return false
+}
+
+// Del deletes the given key, if extant
+func (m *Mock) Del(key interface{}) (wasDeleted bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Del", key)
+
+	if _, ok := m.data[key]; !ok {
+		return false
+	}
+
+	delete(m.data, key)
+	for i, k := range m.order {
+		if k == key {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			break
+		}
+	}
+
+	return true
+}
+
+// Keys returns the stored keys in insertion order
+func (m *Mock) Keys() []interface{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Keys")
+
+	keys := make([]interface{}, len(m.order))
+	copy(keys, m.order)
+	return keys
+}
+
+// Peek returns the stored value for the given key, or nil if not extant
+func (m *Mock) Peek(key interface{}) (value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Peek", key)
+
+	return m.data[key]
+}
+
+// Has reports whether the given key is stored
+func (m *Mock) Has(key interface{}) (ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Has", key)
+
+	_, ok = m.data[key]
+	return
+}
+
+// Drop removes all stored entries
+func (m *Mock) Drop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Drop")
+	m.drop()
+}
+
+// Purge drops all items, as does Drop, though it is recorded as a call to Purge
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (m *Mock) Purge() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Purge")
+	m.drop()
+}
+
+// Size returns the number of stored entries
+func (m *Mock) Size() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("Size")
+
+	return len(m.data)
+}
+
+// AdjustCapacity records the requested capacity; the Mock never evicts
+func (m *Mock) AdjustCapacity(bufCap int) (numEvicted int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.record("AdjustCapacity", bufCap)
+	m.capacity = bufCap
+
+	return 0
+}
+
+func (m *Mock) record(method string, args ...interface{}) {
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// drop removes all stored entries; the lock must be held
+func (m *Mock) drop() {
+	m.data = make(map[interface{}]interface{})
+	m.order = nil
+}
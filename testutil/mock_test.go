@@ -0,0 +1,58 @@
+package testutil_test
+
+import (
+	"testing"
+
+	tenure "github.com/MatthewZito/tenure-go"
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+var _ tenure.LRUController = (*testutil.Mock)(nil)
+
+func TestMockScriptedResults(t *testing.T) {
+	m := testutil.NewMock()
+
+	m.Put("a", 1)
+	m.StubGet("b", 2, true)
+
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Fatalf("Stored read failure; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	if v, ok := m.Get("b"); !ok || v != 2 {
+		t.Fatalf("Stubbed read failure; Have (%v, %v), Want (2, true)", v, ok)
+	}
+
+	m.MissAll(true)
+	if _, ok := m.Get("a"); ok {
+		t.Fatal("MissAll should force unstubbed reads to miss")
+	}
+
+	if n := m.CallCount("Get"); n != 3 {
+		t.Fatalf("Call recording failure; Have %v Get calls, Want %v", n, 3)
+	}
+
+	if calls := m.Calls(); calls[0].Method != "Put" || calls[0].Args[0] != "a" {
+		t.Fatalf("Call recording failure; Have %+v", calls[0])
+	}
+
+	m.Del("a")
+	if m.Size() != 0 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", m.Size(), 0)
+	}
+}
+
+func TestMockRecordsPurge(t *testing.T) {
+	m := testutil.NewMock()
+
+	m.Put("a", 1)
+	m.Purge()
+
+	if m.CallCount("Purge") != 1 || m.CallCount("Drop") != 0 {
+		t.Fatalf("Purge should be recorded as such; Have %+v", m.Calls())
+	}
+
+	if m.Size() != 0 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", m.Size(), 0)
+	}
+}
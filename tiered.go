@@ -0,0 +1,101 @@
+package tenure
+
+// TieredCache layers a small in-process LRUCache (L1) over a second, typically larger or remote, LRUController (L2)
+// Reads are served from L1 where possible; L2 hits are promoted into L1. Writes and invalidations
+// are applied to both tiers, so L1 holds a subset of L2
+type TieredCache struct {
+	l1 *LRUCache
+	l2 LRUController
+}
+
+var _ LRUController = (*TieredCache)(nil)
+
+// NewTiered initializes a new two-tier cache of `l1` over `l2`
+func NewTiered(l1 *LRUCache, l2 LRUController) *TieredCache {
+	return &TieredCache{l1: l1, l2: l2}
+}
+
+// Get attempts to retrieve the value for the given key from L1, falling back to L2
+// L2 hits are promoted into L1
+func (tc *TieredCache) Get(key interface{}) (value interface{}, ok bool) {
+	if value, ok = tc.l1.Get(key); ok {
+		return
+	}
+
+	if value, ok = tc.l2.Get(key); ok {
+		tc.l1.Put(key, value)
+	}
+
+	return
+}
+
+// Put adds or inserts a given key / value pair into both tiers
+// Returns a boolean flag indicating whether an eviction occurred in L1
+func (tc *TieredCache) Put(key, value interface{}) (wasEvicted bool) {
+	tc.l2.Put(key, value)
+	return tc.l1.Put(key, value)
+}
+
+// Del deletes an item corresponding to a given key from both tiers, if extant
+// A boolean flag is returned, indicating whether the item was deleted from either tier
+func (tc *TieredCache) Del(key interface{}) (wasDeleted bool) {
+	d1 := tc.l1.Del(key)
+	d2 := tc.l2.Del(key)
+
+	return d1 || d2
+}
+
+// Keys returns a slice of the keys extant in L2, followed by any extant only in L1
+func (tc *TieredCache) Keys() []interface{} {
+	keys := tc.l2.Keys()
+
+	seen := make(map[interface{}]struct{}, len(keys))
+	for _, k := range keys {
+		seen[k] = struct{}{}
+	}
+
+	for _, k := range tc.l1.Keys() {
+		if _, ok := seen[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// Peek returns the value for the given key from L1, falling back to L2, without promoting it
+func (tc *TieredCache) Peek(key interface{}) (value interface{}) {
+	if tc.l1.Has(key) {
+		return tc.l1.Peek(key)
+	}
+
+	return tc.l2.Peek(key)
+}
+
+// Has returns a boolean flag verifying the existence of a given key in either tier
+func (tc *TieredCache) Has(key interface{}) (ok bool) {
+	return tc.l1.Has(key) || tc.l2.Has(key)
+}
+
+// Drop drops all items from both tiers
+func (tc *TieredCache) Drop() {
+	tc.l1.Drop()
+	tc.l2.Purge()
+}
+
+// Purge drops all items, as does Drop
+//
+// Deprecated: use Drop; Purge is retained to satisfy LRUController
+func (tc *TieredCache) Purge() {
+	tc.Drop()
+}
+
+// Size returns the current size of L2, which holds every item written through the TieredCache
+func (tc *TieredCache) Size() int {
+	return tc.l2.Size()
+}
+
+// AdjustCapacity resizes the capacity of L1; L2 is left as-is
+func (tc *TieredCache) AdjustCapacity(bufCap int) (numEvicted int) {
+	return tc.l1.AdjustCapacity(bufCap)
+}
@@ -0,0 +1,42 @@
+package tenure
+
+import (
+	"testing"
+)
+
+func TestTieredPromotion(t *testing.T) {
+	l1, err := New(1, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	l2, err := New(8, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	tc := NewTiered(l1, l2)
+
+	tc.Put("a", 1)
+	tc.Put("b", 2)
+
+	if l1.Has("a") {
+		t.Fatal("L1 should have evicted its least recently-used item")
+	}
+
+	if v, ok := tc.Get("a"); !ok || v != 1 {
+		t.Fatalf("L2 read failure; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	if !l1.Has("a") {
+		t.Fatal("L2 hits should be promoted into L1")
+	}
+
+	if !tc.Del("a") || l1.Has("a") || l2.Has("a") {
+		t.Fatal("Invalidations should propagate to both tiers")
+	}
+
+	if tc.Size() != 1 || len(tc.Keys()) != 1 {
+		t.Fatalf("Size mismatch; Have %v, Want %v", tc.Size(), 1)
+	}
+}
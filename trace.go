@@ -0,0 +1,170 @@
+package tenure
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// traceMagic heads every trace, identifying its format
+const traceMagic = "TNT1"
+
+// ErrBadTrace is returned by ReadTrace where its input is not a trace
+var ErrBadTrace = errors.New("input is not a trace recorded by WithTraceRecording")
+
+// TraceConfig configures trace recording; see WithTraceRecording
+type TraceConfig struct {
+	// W is the destination of the trace; it is buffered by the cache
+	W io.Writer
+	// SampleRate is the share of keys, in (0, 1], whose accesses are recorded; it defaults to 1
+	SampleRate float64
+	// OnError, if non-nil, is invoked upon write errors, after which recording ceases
+	OnError func(err error)
+}
+
+// WithTraceRecording configures the cache to record its key accesses to a trace, e.g. to size it offline per
+// the sim package, or for research into eviction policies. Every lookup, hit or miss, is an access
+// Each access is recorded as the 64-bit FNV-1a hash of its key, little-endian, following a four-byte header;
+// raw keys never leave the cache. Keys are sampled, rather than accesses, such that every access to a sampled
+// key is recorded and reuse patterns survive sampling; the trace then models a proportionately smaller cache
+// Close must be invoked to flush the trace
+func WithTraceRecording(cfg TraceConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.W == nil {
+			return
+		}
+
+		if cfg.SampleRate <= 0 || cfg.SampleRate > 1 {
+			cfg.SampleRate = 1
+		}
+
+		lc.tracer = &tracer{cfg: cfg, w: bufio.NewWriter(cfg.W), threshold: uint64(cfg.SampleRate * (1 << 32))}
+		lc.tracer.w.WriteString(traceMagic)
+		lc.closers = append(lc.closers, lc.tracer.close)
+	}
+}
+
+// ReadTrace reads a trace recorded via WithTraceRecording, returning its accesses as uint64 keys in order, such
+// that it may be replayed by the sim package
+func ReadTrace(r io.Reader) ([]interface{}, error) {
+	br := bufio.NewReader(r)
+
+	magic := make([]byte, len(traceMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != traceMagic {
+		return nil, ErrBadTrace
+	}
+
+	var (
+		keys []interface{}
+		buf  [8]byte
+	)
+	for {
+		if _, err := io.ReadFull(br, buf[:]); err == io.EOF {
+			return keys, nil
+		} else if err != nil {
+			return keys, ErrBadTrace
+		}
+
+		keys = append(keys, binary.LittleEndian.Uint64(buf[:]))
+	}
+}
+
+/* Utilities */
+
+type tracer struct {
+	cfg TraceConfig
+	// threshold is the bound below which the high 32 bits of a key's mixed hash fall, if its accesses are sampled
+	threshold uint64
+	lock      sync.Mutex
+	w         *bufio.Writer
+	failed    bool
+}
+
+// record writes an access to an already-mapped key, should the key be sampled
+func (t *tracer) record(lc *LRUCache, key interface{}) {
+	h := traceHash(lc.keys.token(key))
+	if mix(h)>>32 >= t.threshold {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.failed {
+		return
+	}
+
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], h)
+	_, err := t.w.Write(buf[:])
+	t.fail(err)
+}
+
+// fail ceases recording upon a write error, reporting it
+func (t *tracer) fail(err error) {
+	if err == nil || t.failed {
+		return
+	}
+
+	t.failed = true
+	if t.cfg.OnError != nil {
+		t.cfg.OnError(err)
+	}
+}
+
+func (t *tracer) close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.failed {
+		return nil
+	}
+
+	return t.w.Flush()
+}
+
+// traceHash returns the 64-bit FNV-1a hash of a key, by its contents for strings, byte slices and integers,
+// and otherwise by its default format
+func traceHash(key interface{}) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+
+	var s string
+	switch k := key.(type) {
+	case string:
+		s = k
+	case []byte:
+		s = string(k)
+	case int:
+		s = strconv.Itoa(k)
+	case HashedKey:
+		s = string(k[:])
+	default:
+		s = fmt.Sprint(k)
+	}
+
+	h := uint64(offset)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= prime
+	}
+
+	return h
+}
+
+// mix scrambles the bits of an FNV-1a hash, whose high bits vary little among short keys, for sampling
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+
+	return h
+}
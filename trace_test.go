@@ -0,0 +1,118 @@
+package tenure
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestTraceRecording(t *testing.T) {
+	var buf bytes.Buffer
+	lru, err := New(4, nil, WithTraceRecording(TraceConfig{W: &buf}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	accesses := []interface{}{"a", "b", "a", 1, "c"}
+	lru.Put("a", 1)
+	for _, k := range accesses {
+		lru.Get(k)
+	}
+
+	if err := lru.Close(); err != nil {
+		t.Fatalf("Failed to close the cache; see %v", err)
+	}
+
+	trace, err := ReadTrace(&buf)
+	if err != nil {
+		t.Fatalf("Failed to read the trace; see %v", err)
+	}
+
+	if len(trace) != len(accesses) {
+		t.Fatalf("Trace length mismatch; Have %v, Want %v", len(trace), len(accesses))
+	}
+
+	for i, k := range accesses {
+		if want := traceHash(k); trace[i] != want {
+			t.Errorf("Access %d mismatch; Have %v, Want %v", i, trace[i], want)
+		}
+	}
+
+	if trace[0] != trace[2] {
+		t.Errorf("Accesses to the same key should be recorded alike; Have %v, Want %v", trace[2], trace[0])
+	}
+}
+
+func TestTraceSampling(t *testing.T) {
+	var buf bytes.Buffer
+	lru, err := New(4, nil, WithTraceRecording(TraceConfig{W: &buf, SampleRate: 0.25}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	// Every key is accessed twice; sampling by key records both accesses or neither
+	for round := 0; round < 2; round++ {
+		for i := 0; i < 1000; i++ {
+			lru.Get(strconv.Itoa(i))
+		}
+	}
+	lru.Close()
+
+	trace, err := ReadTrace(&buf)
+	if err != nil {
+		t.Fatalf("Failed to read the trace; see %v", err)
+	}
+
+	counts := make(map[interface{}]int)
+	for _, k := range trace {
+		counts[k]++
+	}
+
+	for k, n := range counts {
+		if n != 2 {
+			t.Fatalf("Sampled key %v access count mismatch; Have %v, Want %v", k, n, 2)
+		}
+	}
+
+	if len(counts) < 150 || len(counts) > 350 {
+		t.Errorf("Expected roughly a quarter of 1000 keys to be sampled; Have %v", len(counts))
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestTraceWriteError(t *testing.T) {
+	var reported []error
+	lru, err := New(4, nil, WithTraceRecording(TraceConfig{
+		W:       failingWriter{},
+		OnError: func(err error) { reported = append(reported, err) },
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	// Enough accesses to overflow the trace's buffer
+	for i := 0; i < 1000; i++ {
+		lru.Get(i)
+	}
+	lru.Close()
+
+	if len(reported) != 1 {
+		t.Errorf("Expected the write error to be reported once; Have %v reports", len(reported))
+	}
+}
+
+func TestReadTraceRejectsForeignInput(t *testing.T) {
+	if _, err := ReadTrace(bytes.NewBufferString("key\n")); err != ErrBadTrace {
+		t.Errorf("Expected ErrBadTrace; Have %v", err)
+	}
+
+	if _, err := ReadTrace(bytes.NewBufferString(traceMagic + "abc")); err != ErrBadTrace {
+		t.Errorf("Expected ErrBadTrace for a truncated trace; Have %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package tenure
+
+import "time"
+
+// PutWithTTL adds or inserts a given key / value pair into the cache, as does Put, with two lifetimes:
+// once `soft` has elapsed the entry is stale, i.e. eligible for refresh but still servable;
+// once `hard` has elapsed the entry must not be served, and is removed upon its next access or sweep; see WithJanitor
+// A non-positive duration disables the corresponding limit; a soft TTL exceeding the hard TTL is clamped to it
+// TTLs are measured per the cache's expiration mode; see WithExpirationMode, and Expiration for use with PutWith
+func (lc *LRUCache) PutWithTTL(key, value interface{}, soft, hard time.Duration) (wasEvicted bool) {
+	wasEvicted, _ = lc.TryPut(key, value, TTL(soft, hard))
+	return
+}
+
+// Lookup attempts to retrieve the value for the given key from the cache, as does Get,
+// additionally reporting whether the entry is stale i.e. past its soft TTL
+// Unlike Get, Lookup never reads through to a backing Store
+func (lc *LRUCache) Lookup(key interface{}) (value interface{}, stale bool, ok bool) {
+	kv, _, ok := lc.lookup(lc.mapKey(key))
+	if !ok || kv.negative {
+		return nil, false, false
+	}
+
+	value, err := lc.decode(kv.value)
+	return value, kv.stale(lc.clock.Now()), err == nil
+}
+
+// lifetimeBounds are the bucket bounds of the cache's TTL and lifetime histograms
+var lifetimeBounds = []time.Duration{
+	time.Second, 10 * time.Second, time.Minute, 10 * time.Minute,
+	time.Hour, 6 * time.Hour, 24 * time.Hour, 7 * 24 * time.Hour,
+}
+
+// TTLDistribution reports the distribution of TTLs configured via PutWithTTL, and of the lifetimes
+// actually attained by entries bearing a TTL; entries evicted before expiry suggest capacity is the
+// binding constraint, whereas entries that expire suggest TTLs are
+type TTLDistribution struct {
+	// Configured is the distribution of configured TTLs; the hard TTL is recorded where set, else the soft TTL
+	Configured Histogram
+	// EvictedBeforeExpiry is the distribution of lifetimes of entries evicted before their hard expiry
+	EvictedBeforeExpiry Histogram
+	// Expired is the distribution of lifetimes of entries removed upon their hard expiry
+	Expired Histogram
+}
+
+// TTLDistribution returns a snapshot of the cache's TTL and lifetime distributions
+func (lc *LRUCache) TTLDistribution() TTLDistribution {
+	lc.lock.RLock()
+	defer lc.lock.RUnlock()
+
+	d := lc.ttls
+	if d == nil {
+		return newTTLDistribution()
+	}
+
+	return TTLDistribution{
+		Configured:          d.Configured.clone(),
+		EvictedBeforeExpiry: d.EvictedBeforeExpiry.clone(),
+		Expired:             d.Expired.clone(),
+	}
+}
+
+/* Utilities */
+
+func newTTLDistribution() TTLDistribution {
+	return TTLDistribution{
+		Configured:          newHistogram(lifetimeBounds...),
+		EvictedBeforeExpiry: newHistogram(lifetimeBounds...),
+		Expired:             newHistogram(lifetimeBounds...),
+	}
+}
+
+// ttlDist returns the cache's TTL distribution, allocating it upon first use; the write lock must be held
+func (lc *LRUCache) ttlDist() *TTLDistribution {
+	if lc.ttls == nil {
+		d := newTTLDistribution()
+		lc.ttls = &d
+	}
+
+	return lc.ttls
+}
+
+func (lc *LRUCache) observeTTL(soft, hard time.Duration) {
+	if hard > 0 {
+		lc.ttlDist().Configured.Observe(hard)
+	} else if soft > 0 {
+		lc.ttlDist().Configured.Observe(soft)
+	}
+}
+
+// observeLifetime records the lifetime of an entry bearing a TTL upon its removal
+func (lc *LRUCache) observeLifetime(p *pair, expired bool) {
+	if p.hardExpiry.IsZero() && p.softExpiry.IsZero() {
+		return
+	}
+
+	lifetime := lc.clock.Now().Sub(p.created)
+	if expired {
+		lc.ttlDist().Expired.Observe(lifetime)
+	} else {
+		lc.ttlDist().EvictedBeforeExpiry.Observe(lifetime)
+	}
+}
+
+func (p *pair) stamp(now time.Time, spec entrySpec) {
+	soft, hard := spec.soft, spec.hard
+
+	p.created = now
+	p.provenance = spec.provenance
+	p.negative = spec.negative
+	p.delta = spec.delta
+	p.gen++
+
+	p.refreshAt = time.Time{}
+	if spec.refresh > 0 {
+		p.refreshAt = now.Add(spec.refresh)
+	}
+
+	p.sliding, p.hardTTL = false, 0
+
+	if r := spec.restore; r != nil {
+		p.created, p.softExpiry, p.hardExpiry = r.created, r.softExpiry, r.hardExpiry
+		return
+	}
+	p.softExpiry, p.hardExpiry = time.Time{}, time.Time{}
+
+	if hard > 0 {
+		p.hardExpiry = now.Add(hard)
+
+		if soft > hard {
+			soft = hard
+		}
+	}
+
+	if soft > 0 {
+		p.softExpiry = now.Add(soft)
+	}
+
+	if spec.mode == ExpireSliding && hard > 0 {
+		p.sliding, p.hardTTL = true, hard
+	}
+}
+
+func (p *pair) stale(now time.Time) bool {
+	return !p.softExpiry.IsZero() && !now.Before(p.softExpiry)
+}
+
+func (p *pair) expired(now time.Time) bool {
+	return !p.hardExpiry.IsZero() && !now.Before(p.hardExpiry)
+}
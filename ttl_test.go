@@ -0,0 +1,84 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestSoftAndHardTTL(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(4, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithTTL("a", 1, time.Minute, time.Hour)
+
+	if _, stale, ok := lru.Lookup("a"); !ok || stale {
+		t.Fatalf("Fresh entry mismatch; Have (stale=%v, ok=%v), Want (stale=false, ok=true)", stale, ok)
+	}
+
+	clock.Advance(2 * time.Minute)
+
+	if v, stale, ok := lru.Lookup("a"); !ok || !stale || v != 1 {
+		t.Fatalf("Stale entry mismatch; Have (%v, stale=%v, ok=%v), Want (1, stale=true, ok=true)", v, stale, ok)
+	}
+
+	clock.Advance(time.Hour)
+
+	if lru.Has("a") || lru.Peek("a") != nil {
+		t.Fatal("Hard-expired entries should not be reported")
+	}
+
+	if _, ok := lru.Get("a"); ok {
+		t.Fatal("Hard-expired entries must not be served")
+	}
+
+	if lru.Size() != 0 {
+		t.Fatalf("Hard-expired entries should be removed upon access; Have size %v, Want size %v", lru.Size(), 0)
+	}
+
+	lru.PutWithTTL("b", 2, 0, time.Second)
+	lru.Put("b", 3)
+	clock.Advance(time.Minute)
+
+	if v, ok := lru.Get("b"); !ok || v != 3 {
+		t.Fatalf("Put should clear TTLs; Have (%v, %v), Want (3, true)", v, ok)
+	}
+}
+
+func TestTTLDistribution(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+
+	lru, err := New(2, nil, WithClock(clock))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.PutWithTTL("a", 1, 0, time.Minute)
+	lru.PutWithTTL("b", 2, 0, time.Hour)
+	clock.Advance(5 * time.Second)
+
+	// Evicts "a" before its expiry
+	lru.Put("c", 3)
+
+	clock.Advance(2 * time.Hour)
+	lru.Get("b")
+
+	d := lru.TTLDistribution()
+
+	if d.Configured.Count != 2 || d.Configured.Quantile(1) != time.Hour {
+		t.Fatalf("Configured TTL distribution mismatch; Have %+v", d.Configured)
+	}
+
+	if d.EvictedBeforeExpiry.Count != 1 || d.EvictedBeforeExpiry.Mean() != 5*time.Second {
+		t.Fatalf("Evicted lifetime distribution mismatch; Have %+v", d.EvictedBeforeExpiry)
+	}
+
+	if d.Expired.Count != 1 || d.Expired.Mean() != 2*time.Hour+5*time.Second {
+		t.Fatalf("Expired lifetime distribution mismatch; Have %+v", d.Expired)
+	}
+}
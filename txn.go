@@ -0,0 +1,172 @@
+package tenure
+
+import "time"
+
+// Txn is a transaction over the entries of an LRUCache, buffering writes until committed as a whole; see
+// (*LRUCache).Txn. A Txn is not safe for concurrent use, and must not be used once its function returns
+type Txn struct {
+	lc     *LRUCache
+	reads  map[interface{}]txnRead
+	writes map[interface{}]*txnWrite
+	order  []*txnWrite
+}
+
+// txnRead records the revision of the entry for a key as first read by a transaction
+type txnRead struct {
+	key interface{}
+	rev revision
+}
+
+// txnWrite records a write buffered by a transaction, and the entry it yields once prepared for commit
+type txnWrite struct {
+	key, value interface{}
+	deleted    bool
+	spec       entrySpec
+	mapped     interface{}
+	encoded    interface{}
+	class      *reservation
+}
+
+// Txn runs `fn` as a transaction, committing the writes it makes via `tx` all at once should it return nil, or
+// else discarding them and returning its error. Reads via `tx` observe its own writes, and are otherwise those
+// of Peek, neither counted nor designating entries as most recently-used
+// The transaction commits under a single hold of the cache's lock, such that no other operation observes some
+// of its writes but not others, and only if no entry read via `tx` has since been written; else `fn` is run anew,
+// and so must be free of side effects beyond `tx`. `fn` is run without the cache's lock held
+// Should any value be refused, as by TryPut, the transaction is discarded and the error returned. Writes are
+// otherwise committed as though made in order, such that the eviction policy may yet evict entries written by the
+// transaction once exceeding the cache's capacity. Writes are propagated to the backing store, if any, once
+// committed, such that a failed write is reported though the transaction stands
+func (lc *LRUCache) Txn(fn func(tx *Txn) error) error {
+	if lc.latency != nil {
+		defer lc.latency.observe(latencyPut, time.Now())
+	}
+
+	for {
+		tx := &Txn{lc: lc}
+		if err := fn(tx); err != nil {
+			return err
+		}
+
+		if committed, err := tx.commit(); committed || err != nil {
+			return err
+		}
+	}
+}
+
+// Get returns the value for the given key as of the transaction, and whether extant
+func (tx *Txn) Get(key interface{}) (value interface{}, ok bool) {
+	mapped := tx.lc.mapKey(key)
+	token := tx.lc.keys.token(mapped)
+
+	if w, ok := tx.writes[token]; ok {
+		return w.value, !w.deleted
+	}
+
+	value, rev, ok := tx.lc.current(mapped)
+	if _, read := tx.reads[token]; !read {
+		if tx.reads == nil {
+			tx.reads = make(map[interface{}]txnRead)
+		}
+		tx.reads[token] = txnRead{key: mapped, rev: rev}
+	}
+
+	return value, ok
+}
+
+// Put stores the value for the given key upon commit, as does PutWith, per `opts`
+func (tx *Txn) Put(key, value interface{}, opts ...EntryOption) {
+	tx.write(&txnWrite{key: key, value: value, spec: specOf(opts)})
+}
+
+// Del deletes the entry for the given key upon commit, if extant
+func (tx *Txn) Del(key interface{}) {
+	tx.write(&txnWrite{key: key, deleted: true})
+}
+
+/* Utilities */
+
+// write buffers the write, superseding any prior write of its key
+func (tx *Txn) write(w *txnWrite) {
+	w.mapped = tx.lc.mapKey(w.key)
+	token := tx.lc.keys.token(w.mapped)
+
+	if tx.writes == nil {
+		tx.writes = make(map[interface{}]*txnWrite)
+	}
+
+	if prior, ok := tx.writes[token]; ok {
+		*prior = *w
+		return
+	}
+
+	tx.writes[token] = w
+	tx.order = append(tx.order, w)
+}
+
+// commit applies the transaction's writes, returning false should any entry read have since been written
+func (tx *Txn) commit() (committed bool, err error) {
+	lc := tx.lc
+	if len(tx.order) == 0 {
+		return true, nil
+	}
+
+	for _, w := range tx.order {
+		if w.deleted {
+			continue
+		}
+
+		if w.value, err = lc.admit(w.value); err != nil {
+			return false, err
+		}
+
+		w.spec.cost, w.spec.costed = lc.cost(w.key, w.value, w.spec), true
+		if lc.oversized(w.spec.cost) {
+			return false, ErrTooLarge
+		}
+
+		if w.encoded, err = lc.encode(w.value); err != nil {
+			return false, err
+		}
+		w.class = lc.classify(w.key)
+	}
+
+	lc.acquire(tx.order[0].mapped)
+
+	for _, r := range tx.reads {
+		kv, ok := lc.cache[r.key]
+		if r.rev.p == nil && ok || r.rev.p != nil && (!ok || kv != r.rev.p || kv.gen != r.rev.gen) {
+			lc.lock.Unlock()
+			return false, nil
+		}
+	}
+
+	for _, w := range tx.order {
+		if !w.deleted {
+			lc.place(w.mapped, w.encoded, w.class, w.spec.cost, w.spec)
+		} else if kv, ok := lc.cache[w.mapped]; ok {
+			lc.purgeLRUItem(kv)
+			lc.emit(EventDelete, kv)
+			lc.release(kv)
+		}
+	}
+
+	lc.maybeCompact()
+	lc.lock.Unlock()
+
+	for _, w := range tx.order {
+		var perr error
+		if w.deleted {
+			perr = lc.unpersist(w.key)
+		} else {
+			perr = lc.persist(w.key, w.value)
+		}
+
+		if perr != nil && err == nil {
+			err = perr
+		}
+		lc.publish(w.key)
+	}
+
+	return true, err
+}
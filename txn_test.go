@@ -0,0 +1,98 @@
+package tenure
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestTxn(t *testing.T) {
+	lru, _ := New(5, nil)
+	lru.Put("from", int64(10))
+	lru.Put("to", int64(0))
+
+	err := lru.Txn(func(tx *Txn) error {
+		from, _ := tx.Get("from")
+		to, _ := tx.Get("to")
+
+		tx.Put("from", from.(int64)-3)
+		tx.Put("to", to.(int64)+3)
+		tx.Del("pending")
+
+		if v, ok := tx.Get("from"); !ok || v != int64(7) {
+			t.Errorf("Reads should observe the transaction's own writes; Have %v", v)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error committing the transaction; see %v", err)
+	}
+
+	if from, to := lru.Peek("from"), lru.Peek("to"); from != int64(7) || to != int64(3) {
+		t.Errorf("Committed values mismatch; Have %v, %v", from, to)
+	}
+}
+
+func TestTxnDiscard(t *testing.T) {
+	lru, _ := New(5, nil)
+	lru.Put("a", 1)
+
+	abort := errors.New("abort")
+	err := lru.Txn(func(tx *Txn) error {
+		tx.Put("a", 2)
+		tx.Put("b", 2)
+		return abort
+	})
+
+	if err != abort {
+		t.Errorf("Txn should return the error of the function; Have %v, Want %v", err, abort)
+	}
+
+	if v := lru.Peek("a"); v != 1 || lru.Has("b") {
+		t.Errorf("A failed transaction should be discarded; Have %v", lru.Keys())
+	}
+}
+
+func TestTxnTooLarge(t *testing.T) {
+	lru, _ := New(5, nil, WithMaxEntryCost(1), WithSizer(func(key, value interface{}) int64 {
+		return int64(len(value.(string)))
+	}))
+
+	err := lru.Txn(func(tx *Txn) error {
+		tx.Put("a", "a")
+		tx.Put("b", "bb")
+		return nil
+	})
+
+	if err != ErrTooLarge || lru.Has("a") {
+		t.Errorf("A transaction bearing a refused value should be discarded; Have %v, %v", err, lru.Keys())
+	}
+}
+
+func TestTxnConcurrent(t *testing.T) {
+	lru, _ := New(5, nil)
+	lru.Put("a", 0)
+	lru.Put("b", 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				lru.Txn(func(tx *Txn) error {
+					a, _ := tx.Get("a")
+					b, _ := tx.Get("b")
+					tx.Put("a", a.(int)+1)
+					tx.Put("b", b.(int)-1)
+					return nil
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if a, b := lru.Peek("a"), lru.Peek("b"); a != 400 || b != -400 {
+		t.Errorf("Concurrent transactions should not be lost; Have %v, %v", a, b)
+	}
+}
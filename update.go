@@ -0,0 +1,93 @@
+package tenure
+
+import "time"
+
+// Update atomically replaces the value for the given key with that returned by `fn`, which is passed the value
+// extant for the key, if any, and whether an entry, neither expired nor negative, is extant. Should `fn` return
+// write as false, the cache is left as is. The value is stored as by PutWith, per `opts`, and is admitted and
+// written to the backing store, if any, as by TryPut, whose errors Update reports
+// Updates of a key are serialized, such that counters and other aggregates may be read, modified and written
+// without loss. `fn` is invoked without the cache's lock held, and so may itself use the cache; should any other
+// write to the key intervene between reading its value and storing the new, `fn` is invoked anew with the value
+// thereby stored, and so must be free of side effects
+// Only the cache is consulted: an entry extant in the backing store alone is reported as absent, and the new value
+// is written to the store once stored in the cache, such that a failed write is reported though the value remains
+// cached
+func (lc *LRUCache) Update(
+	key interface{},
+	fn func(old interface{}, exists bool) (new interface{}, write bool),
+	opts ...EntryOption,
+) (written bool, err error) {
+	if lc.latency != nil {
+		defer lc.latency.observe(latencyPut, time.Now())
+	}
+
+	mapped := lc.mapKey(key)
+	token := lc.keys.token(mapped)
+	lc.updating.Lock(token)
+	defer lc.updating.Unlock(token)
+
+	for {
+		old, rev, exists := lc.current(mapped)
+
+		value, write := fn(old, exists)
+		if !write {
+			return false, nil
+		}
+
+		if value, err = lc.admit(value); err != nil {
+			return false, err
+		}
+
+		var outcome insertOutcome
+		spec := specOf(opts)
+		spec.ifCurrent, spec.outcome = &rev, &outcome
+
+		spec.cost, spec.costed = lc.cost(key, value, spec), true
+		if lc.oversized(spec.cost) {
+			if rev.p != nil {
+				lc.removeIf(key, rev)
+			}
+			return false, ErrTooLarge
+		}
+
+		if lc.insert(key, value, spec); outcome.conflict {
+			continue
+		}
+
+		if !outcome.written {
+			return false, nil
+		}
+
+		err = lc.persist(key, value)
+		lc.publish(key)
+
+		return true, err
+	}
+}
+
+/* Utilities */
+
+// current returns the value of the entry for an already-mapped key, alongside its revision, without designating
+// it as most recently-used. Expired and negative entries are reported as absent, though their revision is returned
+// all the same; absent entries bear the revision of no entry
+func (lc *LRUCache) current(key interface{}) (value interface{}, rev revision, exists bool) {
+	lc.lock.RLock()
+
+	kv, ok := lc.cache[key]
+	if !ok {
+		lc.lock.RUnlock()
+		return nil, revision{}, false
+	}
+
+	rev = revision{kv, kv.gen}
+	if kv.expired(lc.clock.Now()) || kv.negative {
+		lc.lock.RUnlock()
+		return nil, rev, false
+	}
+
+	value = kv.value
+	lc.lock.RUnlock()
+
+	return lc.decoded(value), rev, true
+}
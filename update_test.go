@@ -0,0 +1,77 @@
+package tenure
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	lru, _ := New(3, nil)
+
+	add := func(old interface{}, exists bool) (interface{}, bool) {
+		if !exists {
+			return 1, true
+		}
+		return old.(int) + 1, true
+	}
+
+	for i := 0; i < 3; i++ {
+		if written, err := lru.Update("a", add); !written || err != nil {
+			t.Fatalf("Update should store the returned value; Have %v, %v", written, err)
+		}
+	}
+
+	if v, _ := lru.Get("a"); v != 3 {
+		t.Errorf("Value mismatch; Have %v, Want %v", v, 3)
+	}
+
+	written, _ := lru.Update("b", func(old interface{}, exists bool) (interface{}, bool) {
+		return nil, false
+	})
+	if written || lru.Has("b") {
+		t.Error("Update should leave the cache as is where the function declines to write")
+	}
+}
+
+func TestUpdateConcurrent(t *testing.T) {
+	lru, _ := New(3, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				lru.Update("n", func(old interface{}, exists bool) (interface{}, bool) {
+					if !exists {
+						return 1, true
+					}
+					return old.(int) + 1, true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if v, _ := lru.Get("n"); v != 800 {
+		t.Errorf("Concurrent updates should not be lost; Have %v, Want %v", v, 800)
+	}
+}
+
+func TestUpdateRetriesOnConflict(t *testing.T) {
+	lru, _ := New(3, nil)
+	lru.Put("a", 1)
+
+	var calls int
+	lru.Update("a", func(old interface{}, exists bool) (interface{}, bool) {
+		if calls++; calls == 1 {
+			// An intervening write supersedes the value read
+			lru.Put("a", 10)
+		}
+		return old.(int) + 1, true
+	})
+
+	if v, _ := lru.Get("a"); v != 11 || calls != 2 {
+		t.Errorf("Update should be retried against the intervening value; Have %v after %v calls", v, calls)
+	}
+}
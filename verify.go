@@ -0,0 +1,38 @@
+package tenure
+
+import "math/rand"
+
+// Verifier reports whether a cached value is trustworthy
+type Verifier func(key, value interface{}) bool
+
+// WithVerifier configures the cache to verify a `sampleRate` fraction of hits with `fn`
+// A value failing verification is invalidated and, if the cache is backed by a Store, reloaded in its stead;
+// else the lookup misses. This repairs the occasional bad read that sneaks in from eventually consistent stores
+// Verification is performed without holding the cache lock
+func WithVerifier(fn Verifier, sampleRate float64) Option {
+	return func(lc *LRUCache) {
+		lc.verifier = fn
+		lc.verifyRate = sampleRate
+	}
+}
+
+/* Utilities */
+
+// verified reports whether the value for a raw key, as of revision `rev`, passes sampled verification,
+// invalidating it if not; an entry superseded while it was being verified is left as is
+func (lc *LRUCache) verified(key, value interface{}, rev revision) bool {
+	if lc.verifier == nil || rand.Float64() >= lc.verifyRate {
+		return true
+	}
+
+	if lc.verifier(key, value) {
+		return true
+	}
+
+	lc.lock.Lock()
+	lc.stats.VerifyFailures++
+	lc.lock.Unlock()
+
+	lc.removeIf(key, rev)
+	return false
+}
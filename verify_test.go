@@ -0,0 +1,68 @@
+package tenure
+
+import (
+	"testing"
+)
+
+func TestVerifierRepairsBadReads(t *testing.T) {
+	ms := newMapStore()
+	ms.data["a"] = "good"
+
+	lru, err := New(4, nil, WithStore(ms), WithVerifier(func(k, v interface{}) bool {
+		return v != "bad"
+	}, 1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", "bad")
+
+	if v, ok := lru.Get("a"); !ok || v != "good" {
+		t.Fatalf("Failed verification should reload from the store; Have (%v, %v), Want (good, true)", v, ok)
+	}
+
+	if v, _ := lru.Get("a"); v != "good" || ms.loads != 1 {
+		t.Fatalf("Reloaded values should be cached; Have %v after %v loads", v, ms.loads)
+	}
+
+	if s := lru.Stats(); s.VerifyFailures != 1 {
+		t.Fatalf("Verification failure count mismatch; Have %v, Want %v", s.VerifyFailures, 1)
+	}
+}
+
+func TestVerifierWithoutStore(t *testing.T) {
+	lru, err := New(4, nil, WithVerifier(func(k, v interface{}) bool { return false }, 1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+
+	if _, ok := lru.Get("a"); ok || lru.Has("a") {
+		t.Fatal("Values failing verification should be invalidated")
+	}
+}
+
+func TestVerifierSparesSupersededEntries(t *testing.T) {
+	var lru *LRUCache
+	lru, err := New(4, nil, WithVerifier(func(k, v interface{}) bool {
+		if v == "bad" {
+			lru.Put(k, "good")
+			return false
+		}
+		return true
+	}, 1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", "bad")
+
+	if _, ok := lru.Get("a"); ok {
+		t.Fatal("Values failing verification should not be served")
+	}
+
+	if v, ok := lru.Get("a"); !ok || v != "good" {
+		t.Fatalf("An entry superseded during verification should not be invalidated; Have (%v, %v), Want (good, true)", v, ok)
+	}
+}
@@ -0,0 +1,65 @@
+package tenure
+
+import "sync"
+
+// watchBuffer is the capacity of the channel returned by Watch
+const watchBuffer = 16
+
+// watcher receives the events of a single key; see Watch
+type watcher struct {
+	ch chan Event
+}
+
+// Watch returns a channel delivering every Event describing a mutation of the given key, i.e. its insertion,
+// update, deletion, eviction or expiry, such that dependent components may react to invalidation without polling
+// Events are sent without blocking, while the cache is locked, to a channel buffered for a handful of events;
+// should the watcher fall behind, events are dropped, which the gaps in their sequence numbers reveal
+// Invoking cancel ends the watch and closes the channel; it may be invoked any number of times
+func (lc *LRUCache) Watch(key interface{}) (events <-chan Event, cancel func()) {
+	token := lc.keys.token(lc.mapKey(key))
+	w := &watcher{ch: make(chan Event, watchBuffer)}
+
+	lc.lock.Lock()
+	if lc.watchers == nil {
+		lc.watchers = make(map[interface{}][]*watcher)
+	}
+	lc.watchers[token] = append(lc.watchers[token], w)
+	lc.lock.Unlock()
+
+	var once sync.Once
+	return w.ch, func() {
+		once.Do(func() {
+			lc.lock.Lock()
+			defer lc.lock.Unlock()
+
+			ws := lc.watchers[token]
+			for i := range ws {
+				if ws[i] == w {
+					ws = append(ws[:i], ws[i+1:]...)
+					break
+				}
+			}
+
+			if len(ws) == 0 {
+				delete(lc.watchers, token)
+			} else {
+				lc.watchers[token] = ws
+			}
+
+			close(w.ch)
+		})
+	}
+}
+
+/* Utilities */
+
+// notify sends the Event to the watchers of the stored key `key`, if any, without blocking
+// The write lock must be held
+func (lc *LRUCache) notify(key interface{}, e Event) {
+	for _, w := range lc.watchers[lc.keys.token(key)] {
+		select {
+		case w.ch <- e:
+		default:
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package tenure
+
+import "testing"
+
+func TestWatch(t *testing.T) {
+	lru, err := New(1, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	events, cancel := lru.Watch("a")
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("a", 3)
+	lru.Put("a", 4)
+	lru.Del("a")
+
+	want := []EventOp{EventPut, EventEvict, EventPut, EventUpdate, EventDelete}
+	for i, op := range want {
+		select {
+		case e := <-events:
+			if e.Op != op || e.Key != "a" {
+				t.Errorf("Event %d mismatch; Have (%v, %v), Want (%v, a)", i, e.Op, e.Key, op)
+			}
+		default:
+			t.Fatalf("Expected event %d (%v); Have none", i, op)
+		}
+	}
+
+	cancel()
+	cancel()
+	lru.Put("a", 5)
+
+	if _, ok := <-events; ok {
+		t.Error("The channel should be closed upon cancellation")
+	}
+
+	if len(lru.watchers) != 0 {
+		t.Errorf("Cancelled watchers should be forgotten; Have %v", lru.watchers)
+	}
+}
+
+func TestWatchDropsWhenFull(t *testing.T) {
+	lru, err := New(10, nil)
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	events, cancel := lru.Watch([]byte("k"))
+	defer cancel()
+
+	for i := 0; i < 2*watchBuffer; i++ {
+		lru.Put([]byte("k"), i)
+	}
+
+	if len(events) != watchBuffer {
+		t.Errorf("A lagging watcher should not block writers; Have %v buffered, Want %v", len(events), watchBuffer)
+	}
+}
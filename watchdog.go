@@ -0,0 +1,92 @@
+package tenure
+
+import (
+	"sync"
+	"time"
+)
+
+// WithCallbackDeadline guards synchronous dispatch of the eviction callback with a watchdog
+// Each notification is dispatched on its own goroutine while the evicting transaction awaits it for at most
+// `deadline`; should the callback overrun, the transaction proceeds, and subsequent notifications are
+// spilled to a queue that is delivered asynchronously, in order, once the overrunning callback returns.
+// Synchronous dispatch resumes once the queue has drained. A hung callback thus delays, rather than
+// freezes, every writer; note the queue is unbounded for as long as the callback remains hung
+func WithCallbackDeadline(deadline time.Duration) Option {
+	return func(lc *LRUCache) {
+		if deadline <= 0 {
+			return
+		}
+
+		lc.watchdog = &watchdog{deadline: deadline}
+	}
+}
+
+/* Utilities */
+
+type notification struct {
+	key   interface{}
+	value interface{}
+}
+
+type watchdog struct {
+	deadline time.Duration
+	lock     sync.Mutex
+	// spilling is set from the moment a callback overruns until the queue has drained
+	spilling bool
+	queue    []notification
+}
+
+// dispatch notifies `fn` of an eviction, reporting whether the callback overran its deadline
+// The deadline is measured by the clock `c`
+func (w *watchdog) dispatch(c Clock, fn Callback, key, value interface{}) (overran bool) {
+	w.lock.Lock()
+	if w.spilling {
+		w.queue = append(w.queue, notification{key, value})
+		w.lock.Unlock()
+		return false
+	}
+	w.lock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(key, value)
+	}()
+
+	fired, halt := c.NewTimer(w.deadline)
+	defer halt()
+
+	select {
+	case <-done:
+		return false
+	case <-fired:
+	}
+
+	w.lock.Lock()
+	w.spilling = true
+	w.lock.Unlock()
+
+	go w.drain(fn, done)
+	return true
+}
+
+// drain awaits the overrunning callback, then delivers spilled notifications until none remain
+func (w *watchdog) drain(fn Callback, overrunning <-chan struct{}) {
+	<-overrunning
+
+	for {
+		w.lock.Lock()
+		if len(w.queue) == 0 {
+			w.spilling = false
+			w.queue = nil
+			w.lock.Unlock()
+			return
+		}
+
+		n := w.queue[0]
+		w.queue = w.queue[1:]
+		w.lock.Unlock()
+
+		fn(n.key, n.value)
+	}
+}
@@ -0,0 +1,80 @@
+package tenure
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCallbackDeadlineSpillsNotifications(t *testing.T) {
+	release := make(chan struct{})
+
+	var (
+		mu      sync.Mutex
+		evicted []interface{}
+	)
+	done := make(chan struct{}, 3)
+
+	lru, err := New(1, func(key, value interface{}) {
+		if key == "a" {
+			<-release
+		}
+
+		mu.Lock()
+		evicted = append(evicted, key)
+		mu.Unlock()
+		done <- struct{}{}
+	}, WithCallbackDeadline(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+
+	start := time.Now()
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+	lru.Put("d", 4)
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Writers should not be frozen by a hung callback; took %v", elapsed)
+	}
+
+	if s := lru.Stats(); s.CallbackOverruns != 1 {
+		t.Fatalf("Overruns mismatch; Have %v, Want %v", s.CallbackOverruns, 1)
+	}
+
+	close(release)
+	for i := 0; i < 3; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Timed out awaiting spilled notifications")
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for i, want := range []interface{}{"a", "b", "c"} {
+		if evicted[i] != want {
+			t.Fatalf("Spilled notifications should be delivered in order; Have %v", evicted)
+		}
+	}
+}
+
+func TestCallbackDeadlineResumesSyncDispatch(t *testing.T) {
+	var n int
+
+	lru, err := New(1, func(key, value interface{}) { n++ }, WithCallbackDeadline(time.Second))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+
+	if n != 1 {
+		t.Fatalf("Prompt callbacks should be dispatched synchronously; Have %v, Want %v", n, 1)
+	}
+}
@@ -0,0 +1,31 @@
+package tenure
+
+// WithLowWatermark configures the cache to evict in batches: the capacity acts as a high watermark, and once
+// an insertion exceeds it, entries are evicted until the cache is down to a low watermark of `ratio` times
+// the capacity, rather than one entry per insertion. Bursty inserts thus enact the eviction policy once per
+// batch. `ratio` must lie in (0, 1); the low watermark is never less than one entry, and tracks adjustments
+// of the capacity
+func WithLowWatermark(ratio float64) Option {
+	return func(lc *LRUCache) {
+		if ratio <= 0 || ratio >= 1 {
+			return
+		}
+
+		lc.lowWater = ratio
+	}
+}
+
+/* Utilities */
+
+// lowWatermark returns the size to which the cache is reduced upon exceeding its capacity
+func (lc *LRUCache) lowWatermark() int {
+	if lc.lowWater == 0 {
+		return lc.capacity
+	}
+
+	if low := int(lc.lowWater * float64(lc.capacity)); low > 1 {
+		return low
+	}
+
+	return 1
+}
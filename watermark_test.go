@@ -0,0 +1,57 @@
+package tenure
+
+import "testing"
+
+func TestLowWatermark(t *testing.T) {
+	evicted := 0
+	lru, err := New(10, func(key, value interface{}) { evicted++ }, WithLowWatermark(0.7))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if lru.Put(i, i) {
+			t.Fatal("No eviction should occur until the capacity is exceeded")
+		}
+	}
+
+	if !lru.Put(10, 10) {
+		t.Fatal("Exceeding the capacity should enact the eviction policy")
+	}
+
+	if lru.Size() != 7 || evicted != 4 {
+		t.Fatalf("The cache should be reduced to its low watermark; Have (size=%v, evicted=%v), Want (size=7, evicted=4)", lru.Size(), evicted)
+	}
+
+	if lru.Has(3) || !lru.Has(4) || !lru.Has(10) {
+		t.Fatal("Batch eviction should remove the least recently-used entries")
+	}
+
+	for i := 11; i < 14; i++ {
+		if lru.Put(i, i) {
+			t.Fatal("No eviction should occur until the capacity is exceeded anew")
+		}
+	}
+
+	lru.AdjustCapacity(4)
+	lru.Put(14, 14)
+
+	if lru.Size() != 2 {
+		t.Fatalf("The low watermark should track the capacity; Have size %v, Want size %v", lru.Size(), 2)
+	}
+}
+
+func TestLowWatermarkFloor(t *testing.T) {
+	lru, err := New(2, nil, WithLowWatermark(0.1))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+
+	if lru.Size() != 1 || !lru.Has("c") {
+		t.Fatalf("The low watermark should retain at least the inserted entry; Have %v", lru.Keys())
+	}
+}
@@ -0,0 +1,277 @@
+package tenure
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned for writes to the backing Store of a write-behind cache made after it was closed
+var ErrClosed = errors.New("write-behind cache is closed")
+
+// WriteMode designates how writes to the cache are propagated to its backing Store
+type WriteMode int
+
+const (
+	// WriteAround leaves the backing Store untouched by writes; it is only ever read through
+	WriteAround WriteMode = iota
+	// WriteThrough synchronously writes to the backing Store on every Put and Del
+	WriteThrough
+	// WriteBehind buffers writes and flushes them to the backing Store asynchronously, in batches
+	WriteBehind
+)
+
+// WriteBehindConfig configures the buffering of a write-behind cache
+type WriteBehindConfig struct {
+	// BatchSize is the number of buffered writes that triggers an early flush; defaults to 64
+	BatchSize int
+	// Interval is the maximum duration a write remains buffered; defaults to one second
+	Interval time.Duration
+	// MaxRetries is the number of times a failed write is retried before being dropped
+	MaxRetries int
+	// Backoff is the delay before the first retry of a failed write, doubling with each retry thereafter;
+	// defaults to 10 milliseconds
+	Backoff time.Duration
+}
+
+// WithWriteThrough configures the cache to synchronously write to its backing Store on every Put and Del
+// Should a store write fail, the error is reported to the write error handler, and Put or Del leave the cache
+// unmodified. Deletions made in bulk, as by DeleteFunc, Rename or Commit, remove their entries regardless
+func WithWriteThrough() Option {
+	return func(lc *LRUCache) {
+		lc.writeMode = WriteThrough
+	}
+}
+
+// WithWriteBehind configures the cache to buffer writes to its backing Store, flushing them asynchronously
+// Buffered writes to the same key are coalesced; writes that fail after all retries are reported to the
+// write error handler and dropped. Close must be invoked to flush outstanding writes and release resources;
+// writes to the backing Store thereafter fail with ErrClosed
+func WithWriteBehind(cfg WriteBehindConfig) Option {
+	return func(lc *LRUCache) {
+		if cfg.BatchSize <= 0 {
+			cfg.BatchSize = 64
+		}
+
+		if cfg.Interval <= 0 {
+			cfg.Interval = time.Second
+		}
+
+		if cfg.Backoff <= 0 {
+			cfg.Backoff = 10 * time.Millisecond
+		}
+
+		lc.writeMode = WriteBehind
+		lc.writer = &writeBehind{cfg: cfg}
+	}
+}
+
+// WithWriteErrorHandler registers a handler to be invoked with any error encountered while writing to the backing Store
+func WithWriteErrorHandler(fn func(key interface{}, err error)) Option {
+	return func(lc *LRUCache) {
+		lc.onWriteError = fn
+	}
+}
+
+// Flush synchronously writes all buffered writes to the backing Store
+// Returns the last error encountered, if any; Flush is a no-op unless the cache is write-behind
+func (lc *LRUCache) Flush() error {
+	if lc.writer == nil {
+		return nil
+	}
+
+	return lc.writer.flush()
+}
+
+/* Utilities */
+
+type pendingWrite struct {
+	key     interface{}
+	value   interface{}
+	deleted bool
+}
+
+type writeBehind struct {
+	cfg  WriteBehindConfig
+	lc   *LRUCache
+	lock sync.Mutex
+	buf  map[interface{}]pendingWrite
+	// inflight are the writes of the batch being flushed, which remain pending until written
+	inflight map[interface{}]pendingWrite
+	closed   bool
+	flushMu  sync.Mutex
+	kick     chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func (wb *writeBehind) start(lc *LRUCache) {
+	wb.lc = lc
+	wb.buf = make(map[interface{}]pendingWrite)
+	wb.kick = make(chan struct{}, 1)
+	wb.stop = make(chan struct{})
+	wb.done = make(chan struct{})
+
+	go wb.run()
+}
+
+func (wb *writeBehind) run() {
+	defer close(wb.done)
+
+	for {
+		fired, halt := wb.lc.clock.NewTimer(wb.cfg.Interval)
+
+		select {
+		case <-fired:
+		case <-wb.kick:
+			halt()
+		case <-wb.stop:
+			halt()
+			return
+		}
+
+		wb.flush()
+	}
+}
+
+func (wb *writeBehind) enqueue(w pendingWrite) error {
+	wb.lock.Lock()
+	if wb.closed {
+		wb.lock.Unlock()
+		return ErrClosed
+	}
+
+	wb.buf[wb.lc.keys.token(w.key)] = w
+	full := len(wb.buf) >= wb.cfg.BatchSize
+	wb.lock.Unlock()
+
+	if full {
+		select {
+		case wb.kick <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (wb *writeBehind) pending(key interface{}) (value interface{}, deleted bool, ok bool) {
+	if wb == nil {
+		return nil, false, false
+	}
+
+	wb.lock.Lock()
+	defer wb.lock.Unlock()
+
+	token := wb.lc.keys.token(key)
+	w, ok := wb.buf[token]
+	if !ok {
+		w, ok = wb.inflight[token]
+	}
+
+	return w.value, w.deleted, ok
+}
+
+func (wb *writeBehind) flush() (err error) {
+	wb.flushMu.Lock()
+	defer wb.flushMu.Unlock()
+
+	wb.lock.Lock()
+	batch := wb.buf
+	wb.buf = make(map[interface{}]pendingWrite)
+	wb.inflight = batch
+	wb.lock.Unlock()
+
+	defer func() {
+		wb.lock.Lock()
+		wb.inflight = nil
+		wb.lock.Unlock()
+	}()
+
+	for token, w := range batch {
+		if werr := wb.write(w); werr != nil {
+			err = werr
+			wb.lc.writeFailed(w.key, werr)
+		}
+
+		wb.lock.Lock()
+		delete(wb.inflight, token)
+		wb.lock.Unlock()
+	}
+
+	return
+}
+
+// write writes `w` to the backing store, retrying with exponential backoff upon failure
+func (wb *writeBehind) write(w pendingWrite) (err error) {
+	delay := wb.cfg.Backoff
+	for attempt := 0; ; attempt++ {
+		if err = wb.lc.write(w); err == nil || attempt >= wb.cfg.MaxRetries {
+			return
+		}
+
+		pause(wb.lc.clock, delay, nil)
+		delay *= 2
+	}
+}
+
+func (wb *writeBehind) close() error {
+	wb.lock.Lock()
+	wb.closed = true
+	wb.lock.Unlock()
+
+	select {
+	case <-wb.stop:
+	default:
+		close(wb.stop)
+		<-wb.done
+	}
+
+	return wb.flush()
+}
+
+// persist propagates a write of the given raw key to the backing store per the configured write mode
+// Returns the error of a failed synchronous write, in which case the cache must not be modified
+func (lc *LRUCache) persist(key, value interface{}) error {
+	switch lc.writeMode {
+	case WriteThrough:
+		if err := lc.write(pendingWrite{key: key, value: value}); err != nil {
+			lc.writeFailed(key, err)
+			return err
+		}
+	case WriteBehind:
+		return lc.writer.enqueue(pendingWrite{key: key, value: value})
+	}
+
+	return nil
+}
+
+// unpersist propagates a deletion of the given raw key to the backing store per the configured write mode
+// Returns the error of a failed synchronous write, as does persist
+func (lc *LRUCache) unpersist(key interface{}) error {
+	switch lc.writeMode {
+	case WriteThrough:
+		if err := lc.write(pendingWrite{key: key, deleted: true}); err != nil {
+			lc.writeFailed(key, err)
+			return err
+		}
+	case WriteBehind:
+		return lc.writer.enqueue(pendingWrite{key: key, deleted: true})
+	}
+
+	return nil
+}
+
+func (lc *LRUCache) write(w pendingWrite) error {
+	if w.deleted {
+		return lc.store.Delete(w.key)
+	}
+
+	return lc.store.Write(w.key, w.value)
+}
+
+func (lc *LRUCache) writeFailed(key interface{}, err error) {
+	if lc.onWriteError != nil {
+		lc.onWriteError(key, err)
+	}
+}
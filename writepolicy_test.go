@@ -0,0 +1,204 @@
+package tenure
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MatthewZito/tenure-go/testutil"
+)
+
+func TestWriteThrough(t *testing.T) {
+	ms := newMapStore()
+	var failures []interface{}
+
+	lru, err := New(4, nil, WithStore(ms), WithWriteThrough(), WithWriteErrorHandler(func(k interface{}, err error) {
+		failures = append(failures, k)
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	if v, ok := ms.get("a"); !ok || v != 1 {
+		t.Fatalf("Write-through failure; Have (%v, %v), Want (1, true)", v, ok)
+	}
+
+	lru.Del("a")
+	if _, ok := ms.get("a"); ok {
+		t.Fatal("Deletions should be written through to the store")
+	}
+
+	ms.fail = errors.New("unavailable")
+	lru.Put("b", 2)
+
+	if lru.Has("b") {
+		t.Fatal("Failed store writes should leave the cache unmodified")
+	}
+
+	if len(failures) != 1 || failures[0] != "b" {
+		t.Fatalf("Write error handler failure; Have %v", failures)
+	}
+
+	ms.fail = nil
+	lru.Put("c", 3)
+	ms.fail = errors.New("unavailable")
+
+	if lru.Del("c") || !lru.Has("c") {
+		t.Fatal("Failed store deletions should leave the cache unmodified")
+	}
+}
+
+func TestWriteBehind(t *testing.T) {
+	ms := newMapStore()
+
+	lru, err := New(2, nil, WithStore(ms), WithWriteBehind(WriteBehindConfig{BatchSize: 100, Interval: time.Hour}))
+	if err != nil {
+		t.Fatalf("Failed to initialize a new LRU cache instance; see %v", err)
+	}
+
+	lru.Put("a", 1)
+	lru.Put("a", 2)
+	lru.Put("b", 3)
+	lru.Put("c", 4)
+
+	if _, ok := ms.get("a"); ok {
+		t.Fatal("Writes should be buffered until flushed")
+	}
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 2 {
+		t.Fatalf("Buffered writes should be visible to read-through; Have (%v, %v), Want (2, nil)", v, err)
+	}
+
+	if err := lru.Close(); err != nil {
+		t.Fatalf("Flush failure; see %v", err)
+	}
+
+	if v, ok := ms.get("a"); !ok || v != 2 || ms.writes != 3 {
+		t.Fatalf("Buffered writes should be coalesced and flushed; Have (%v, %v) after %v writes", v, ok, ms.writes)
+	}
+}
+
+// blockingStore blocks writes until released
+type blockingStore struct {
+	*mapStore
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (bs blockingStore) Write(key, value interface{}) error {
+	bs.entered <- struct{}{}
+	<-bs.release
+	return bs.mapStore.Write(key, value)
+}
+
+func TestWriteBehindInFlight(t *testing.T) {
+	bs := blockingStore{mapStore: newMapStore(), entered: make(chan struct{}), release: make(chan struct{})}
+
+	lru, _ := New(2, nil, WithStore(bs), WithWriteBehind(WriteBehindConfig{BatchSize: 100, Interval: time.Hour}))
+	lru.Put("a", 1)
+	lru.Del("a")
+	lru.Put("a", 2)
+
+	done := make(chan error)
+	go func() { done <- lru.Flush() }()
+	<-bs.entered
+
+	if v, err := lru.GetOrLoad("a"); err != nil || v != 2 {
+		t.Fatalf("Writes being flushed should be visible to read-through; Have (%v, %v), Want (2, nil)", v, err)
+	}
+
+	close(bs.release)
+	if err := <-done; err != nil {
+		t.Fatalf("Flush failure; see %v", err)
+	}
+
+	if _, _, ok := lru.writer.pending("a"); ok {
+		t.Fatal("Flushed writes should no longer be pending")
+	}
+	lru.Close()
+}
+
+func TestWriteBehindRetries(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	ms := newMapStore()
+	ms.fail = errors.New("unavailable")
+	var failures []interface{}
+
+	lru, _ := New(2, nil, WithClock(clock), WithStore(ms), WithWriteErrorHandler(func(k interface{}, err error) {
+		failures = append(failures, k)
+	}), WithWriteBehind(WriteBehindConfig{BatchSize: 100, Interval: time.Hour, MaxRetries: 2, Backoff: 10 * time.Millisecond}))
+	lru.Put("a", 1)
+
+	done := make(chan error)
+	go func() { done <- lru.Flush() }()
+
+	// Pending are the flush interval's timer and that of the backoff, which doubles with each retry
+	clock.BlockUntil(2)
+	clock.Advance(10 * time.Millisecond)
+	clock.BlockUntil(2)
+	clock.Advance(10 * time.Millisecond)
+
+	select {
+	case <-done:
+		t.Fatal("Retries should back off exponentially")
+	default:
+	}
+
+	clock.Advance(10 * time.Millisecond)
+	if err := <-done; err == nil {
+		t.Fatal("Expected the flush of a failing write to fail")
+	}
+
+	if len(failures) != 1 || failures[0] != "a" {
+		t.Errorf("Write error handler failure; Have %v", failures)
+	}
+	lru.Close()
+}
+
+func TestWriteBehindInterval(t *testing.T) {
+	clock := testutil.NewFakeClock(time.Unix(0, 0))
+	ms := newMapStore()
+
+	lru, _ := New(2, nil, WithClock(clock), WithStore(ms), WithWriteBehind(WriteBehindConfig{BatchSize: 100, Interval: time.Minute}))
+	defer lru.Close()
+
+	lru.Put("a", 1)
+
+	// The writer arms its next timer once its flush is done
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	clock.BlockUntil(1)
+
+	if v, ok := ms.get("a"); !ok || v != 1 {
+		t.Fatalf("Buffered writes should be flushed once the interval elapses; Have (%v, %v), Want (1, true)", v, ok)
+	}
+}
+
+func TestWriteBehindClosed(t *testing.T) {
+	ms := newMapStore()
+
+	lru, _ := New(2, nil, WithStore(ms), WithWriteBehind(WriteBehindConfig{}))
+	lru.Put("a", 1)
+	if err := lru.Close(); err != nil {
+		t.Fatalf("Flush failure; see %v", err)
+	}
+
+	if _, err := lru.TryPut("b", 2); err != ErrClosed {
+		t.Fatalf("Writes after Close should fail; Have %v, Want %v", err, ErrClosed)
+	}
+
+	if lru.Has("b") || lru.Del("a") {
+		t.Fatal("Writes after Close should leave the cache unmodified")
+	}
+
+	if _, ok := ms.get("b"); ok {
+		t.Fatal("Writes after Close should never reach the store")
+	}
+}
+
+func TestWriteModeRequiresStore(t *testing.T) {
+	if _, err := New(2, nil, WithWriteThrough()); err == nil {
+		t.Fatal("Expected an error when initializing a write-through cache without a Store")
+	}
+}